@@ -0,0 +1,5 @@
+data "sonarcloud_metrics" "all" {}
+
+output "coverage_metrics" {
+  value = [for metric in data.sonarcloud_metrics.all.metrics : metric.key if metric.domain == "Coverage"]
+}
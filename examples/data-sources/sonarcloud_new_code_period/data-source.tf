@@ -0,0 +1,3 @@
+data "sonarcloud_new_code_period" "example_project" {
+  project_key = "example_project"
+}
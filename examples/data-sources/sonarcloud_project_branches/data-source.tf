@@ -0,0 +1,7 @@
+data "sonarcloud_project_branches" "example" {
+  project_key = "example-project"
+}
+
+output "failing_branches" {
+  value = [for branch in data.sonarcloud_project_branches.example.branches : branch.name if branch.quality_gate_status == "ERROR"]
+}
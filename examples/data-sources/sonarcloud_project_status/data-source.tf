@@ -0,0 +1,12 @@
+data "sonarcloud_project_status" "release" {
+  project_key = "example-project"
+  branch      = "release/1.0"
+}
+
+output "quality_gate_passed" {
+  value = data.sonarcloud_project_status.release.quality_gate_status == "OK"
+}
+
+output "coverage" {
+  value = lookup(data.sonarcloud_project_status.release.measures, "coverage", null)
+}
@@ -1 +1,5 @@
 data "sonarcloud_projects" "all" {}
+
+data "sonarcloud_projects" "team_a" {
+  tag = "team-a"
+}
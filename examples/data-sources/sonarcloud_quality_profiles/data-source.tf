@@ -0,0 +1,4 @@
+data "sonarcloud_quality_profiles" "java_defaults" {
+  language      = "java"
+  defaults_only = true
+}
@@ -0,0 +1,5 @@
+data "sonarcloud_rules" "java_bugs" {
+  language = "java"
+  type     = "BUG"
+  severity = "BLOCKER"
+}
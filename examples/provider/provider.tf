@@ -11,3 +11,14 @@ provider "sonarcloud" {
   organization = var.organization
   token        = var.token
 }
+
+# Use the US region through a corporate proxy that intercepts TLS
+provider "sonarcloud" {
+  alias        = "us"
+  organization = var.organization
+  token        = var.token
+  base_url     = "https://sonarqube.us"
+  http_timeout = 30
+  proxy_url    = "http://proxy.example.com:3128"
+  ca_bundle    = "/etc/ssl/certs/corporate-ca.pem"
+}
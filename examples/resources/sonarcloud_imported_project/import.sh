@@ -0,0 +1,3 @@
+#!/bin/sh
+# import an imported project using <project_key>
+terraform import "sonarcloud_imported_project.example_project" "example-org_example-repository"
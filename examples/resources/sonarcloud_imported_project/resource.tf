@@ -0,0 +1,4 @@
+resource "sonarcloud_imported_project" "example_project" {
+  alm        = "github"
+  repository = "example-org/example-repository"
+}
@@ -0,0 +1,6 @@
+#!/bin/sh
+# import the long-lived branches pattern of the organization using <organization>
+terraform import "sonarcloud_long_lived_branches_pattern.organization" "example-org"
+
+# import the long-lived branches pattern of a project using <project_key>
+terraform import "sonarcloud_long_lived_branches_pattern.example_project" "example-project"
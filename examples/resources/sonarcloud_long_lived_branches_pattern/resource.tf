@@ -0,0 +1,8 @@
+resource "sonarcloud_long_lived_branches_pattern" "organization" {
+  regex = "(branch|release)-.*"
+}
+
+resource "sonarcloud_long_lived_branches_pattern" "example_project" {
+  project_key = "example-project"
+  regex       = "release/.*"
+}
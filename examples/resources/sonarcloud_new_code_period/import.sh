@@ -0,0 +1,9 @@
+#!/bin/sh
+# import the new code definition of the organization using <organization>
+terraform import "sonarcloud_new_code_period.organization" "example-org"
+
+# import the new code definition of a project using <project_key>
+terraform import "sonarcloud_new_code_period.example_project" "example_project"
+
+# import the new code definition of a branch using <project_key>,<branch>
+terraform import "sonarcloud_new_code_period.example_branch" "example_project,feature/example"
@@ -0,0 +1,16 @@
+resource "sonarcloud_new_code_period" "organization" {
+  type  = "NUMBER_OF_DAYS"
+  value = "30"
+}
+
+resource "sonarcloud_new_code_period" "example_project" {
+  project_key = "example_project"
+  type        = "PREVIOUS_VERSION"
+}
+
+resource "sonarcloud_new_code_period" "example_branch" {
+  project_key = "example_project"
+  branch      = "feature/example"
+  type        = "REFERENCE_BRANCH"
+  value       = "main"
+}
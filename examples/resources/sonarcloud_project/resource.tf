@@ -2,4 +2,9 @@ resource "sonarcloud_project" "example_project" {
   key        = "my-unique-project-key"
   name       = "My not-unique project name"
   visibility = "private"
+
+  tags = ["team-a"]
+
+  source_exclusions   = ["**/vendor/**"]
+  coverage_exclusions = ["**/*_test.go"]
 }
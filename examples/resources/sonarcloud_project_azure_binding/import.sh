@@ -0,0 +1,3 @@
+#!/bin/sh
+# import a Azure DevOps binding using <project_key>
+terraform import "sonarcloud_project_azure_binding.example_project" "example_project"
@@ -0,0 +1,11 @@
+resource "sonarcloud_project" "example_project" {
+  key        = "example_project"
+  name       = "Example project"
+  visibility = "private"
+}
+
+resource "sonarcloud_project_azure_binding" "example_project" {
+  project_key     = sonarcloud_project.example_project.key
+  project_name    = "Example Azure DevOps project"
+  repository_name = "example-repository"
+}
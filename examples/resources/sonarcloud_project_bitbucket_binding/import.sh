@@ -0,0 +1,3 @@
+#!/bin/sh
+# import a Bitbucket Cloud binding using <project_key>
+terraform import "sonarcloud_project_bitbucket_binding.example_project" "example_project"
@@ -0,0 +1,10 @@
+resource "sonarcloud_project" "example_project" {
+  key        = "example_project"
+  name       = "Example project"
+  visibility = "private"
+}
+
+resource "sonarcloud_project_bitbucket_binding" "example_project" {
+  project_key = sonarcloud_project.example_project.key
+  repository  = "example-repository"
+}
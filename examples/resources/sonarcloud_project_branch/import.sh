@@ -0,0 +1,3 @@
+#!/bin/sh
+# import a project branch using <project_key>,<name>
+terraform import "sonarcloud_project_branch.release" "example-project,release/1.0"
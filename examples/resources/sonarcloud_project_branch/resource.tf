@@ -0,0 +1,5 @@
+resource "sonarcloud_project_branch" "release" {
+  project_key        = "example-project"
+  name               = "release/1.0"
+  keep_when_inactive = true
+}
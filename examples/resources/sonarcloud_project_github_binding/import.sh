@@ -0,0 +1,3 @@
+#!/bin/sh
+# import a GitHub binding using <project_key>
+terraform import "sonarcloud_project_github_binding.example_project" "example_project"
@@ -0,0 +1,3 @@
+#!/bin/sh
+# import a GitLab binding using <project_key>
+terraform import "sonarcloud_project_gitlab_binding.example_project" "example_project"
@@ -0,0 +1,6 @@
+#!/bin/sh
+# import an organization setting using <key>
+terraform import "sonarcloud_project_setting.exclusions" "sonar.exclusions"
+
+# import a setting for a specific project using <key>,<project_key>
+terraform import "sonarcloud_project_setting.exclusions" "sonar.exclusions,example_project"
@@ -0,0 +1,22 @@
+resource "sonarcloud_project" "example_project" {
+  key        = "example_project"
+  name       = "Example project"
+  visibility = "private"
+}
+
+resource "sonarcloud_project_setting" "exclusions" {
+  project_key = sonarcloud_project.example_project.key
+  key         = "sonar.exclusions"
+  values      = ["**/vendor/**", "**/generated/**"]
+}
+
+resource "sonarcloud_project_setting" "ignore_issues" {
+  project_key = sonarcloud_project.example_project.key
+  key         = "sonar.issue.ignore.multicriteria"
+  field_values = [
+    {
+      ruleKey     = "java:S106"
+      resourceKey = "**/*.java"
+    }
+  ]
+}
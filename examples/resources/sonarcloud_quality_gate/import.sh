@@ -1,3 +1,6 @@
 #!/bin/sh
 # import a quality gate using <quality gate name>
 terraform import "sonarcloud_quality_gate.very_strict" "Very Strict"
+
+# import a quality gate using <gate_id>
+terraform import "sonarcloud_quality_gate.very_strict" "12345"
@@ -17,3 +17,16 @@ resource "sonarcloud_quality_gate" "awesome" {
   ]
 
 }
+
+resource "sonarcloud_quality_gate" "team" {
+  name      = "Team Quality Gate"
+  copy_from = "Sonar way"
+  // Stricter coverage on new code, the other conditions are copied from Sonar way
+  conditions = [
+    {
+      metric = "new_coverage"
+      error  = 90
+      op     = "LT"
+    }
+  ]
+}
@@ -0,0 +1,3 @@
+#!/bin/sh
+# import a quality gate selection using <gate_id>, which selects all projects that currently use the gate
+terraform import "sonarcloud_quality_gate_selection.example_quality_gate_selection" "12345"
@@ -0,0 +1,3 @@
+#!/bin/sh
+# import a quality profile using <language>,<quality profile name>
+terraform import "sonarcloud_quality_profile.awesome" "java,My Awesome Java Profile"
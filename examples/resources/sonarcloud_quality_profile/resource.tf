@@ -0,0 +1,26 @@
+resource "sonarcloud_quality_profile" "awesome" {
+  name       = "My Awesome Java Profile"
+  language   = "java"
+  is_default = true
+  rules = [
+    // Unused local variables
+    {
+      rule     = "java:S1481"
+      severity = "MAJOR"
+    },
+    // Methods should not have too many parameters
+    {
+      rule = "java:S107"
+      params = {
+        max = "5"
+      }
+    }
+  ]
+}
+
+// Copy the rules of an existing profile
+resource "sonarcloud_quality_profile" "derived" {
+  name      = "My Derived Java Profile"
+  language  = "java"
+  copy_from = sonarcloud_quality_profile.awesome.key
+}
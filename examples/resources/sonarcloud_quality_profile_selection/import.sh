@@ -0,0 +1,3 @@
+#!/bin/sh
+# import a quality profile selection using <profile_key>,<language>, which selects all projects that currently use the profile
+terraform import "sonarcloud_quality_profile_selection.example_quality_profile_selection" "AU-TpxcA-iU5OvuD2FLz,java"
@@ -0,0 +1,12 @@
+resource "sonarcloud_quality_profile" "awesome_java" {
+  name     = "My Awesome Java Profile"
+  language = "java"
+}
+
+data "sonarcloud_projects" "all" {}
+
+resource "sonarcloud_quality_profile_selection" "example_quality_profile_selection" {
+  profile_key  = sonarcloud_quality_profile.awesome_java.key
+  language     = sonarcloud_quality_profile.awesome_java.language
+  project_keys = [for project in data.sonarcloud_projects.all.projects : project.key if project.name == "My Awesome Project"]
+}
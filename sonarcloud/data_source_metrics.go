@@ -0,0 +1,98 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/metrics"
+)
+
+type dataSourceMetricsType struct{}
+
+func (d dataSourceMetricsType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This data source retrieves the metrics that are available in SonarCloud, e.g. to base quality gate conditions on.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"metrics": {
+				Computed:    true,
+				Description: "The available metrics.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"key": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The key of the metric, e.g. `new_coverage`.",
+					},
+					"name": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The name of the metric.",
+					},
+					"type": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The type of the metric's values, e.g. `PERCENT` or `RATING`.",
+					},
+					"domain": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The domain of the metric, e.g. `Coverage`.",
+					},
+					"direction": {
+						Type:     types.Int64Type,
+						Computed: true,
+						Description: "Whether higher values of the metric are better (`1`), worse (`-1`), " +
+							"or neither (`0`).",
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (d dataSourceMetricsType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceMetrics{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceMetrics struct {
+	p provider
+}
+
+func (d dataSourceMetrics) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_metrics", req.Config)(&resp.Diagnostics)
+
+	response, err := d.p.client.Metrics.SearchAll(metrics.SearchRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the metrics",
+			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result := DataMetrics{}
+	allMetrics := make([]DataMetric, len(response.Metrics))
+	for i, metric := range response.Metrics {
+		allMetrics[i] = DataMetric{
+			Key:       types.String{Value: metric.Key},
+			Name:      types.String{Value: metric.Name},
+			Type:      types.String{Value: metric.Type},
+			Domain:    types.String{Value: metric.Domain},
+			Direction: types.Int64{Value: int64(metric.Direction)},
+		}
+	}
+	result.Metrics = allMetrics
+	result.ID = types.String{Value: d.p.organization}
+
+	diags := resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
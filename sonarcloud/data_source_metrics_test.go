@@ -0,0 +1,32 @@
+package sonarcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceMetrics(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMetricsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarcloud_metrics.test", "metrics.*", map[string]string{
+						"key":       "new_coverage",
+						"type":      "PERCENT",
+						"direction": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMetricsConfig() string {
+	return `
+data "sonarcloud_metrics" "test" {}
+`
+}
@@ -0,0 +1,108 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceNewCodePeriodType struct{}
+
+func (d dataSourceNewCodePeriodType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This data source retrieves the effective new code definition of the organization, a project or a branch of a project.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The key of the project to retrieve the new code definition for. If omitted, the definition of the organization is retrieved.",
+			},
+			"branch": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of the branch to retrieve the new code definition for. Requires `project_key` to be set.",
+			},
+			"type": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The type of the new code definition.",
+			},
+			"value": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The value of the new code definition, if any.",
+			},
+			"inherited": {
+				Type:        types.BoolType,
+				Computed:    true,
+				Description: "Whether the new code definition is inherited from the project or organization.",
+			},
+		},
+	}, nil
+}
+
+func (d dataSourceNewCodePeriodType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceNewCodePeriod{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceNewCodePeriod struct {
+	p provider
+}
+
+func (d dataSourceNewCodePeriod) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_new_code_period", req.Config)(&resp.Diagnostics)
+
+	var config DataNewCodePeriod
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Branch.Value != "" && config.ProjectKey.Value == "" {
+		resp.Diagnostics.AddError(
+			"Invalid new code definition",
+			"The branch can only be set together with the project_key.",
+		)
+		return
+	}
+
+	response, err := showNewCodePeriod(d.p.client, config.ProjectKey.Value, config.Branch.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the new code definition",
+			fmt.Sprintf("The Show request returned an error: %+v", err),
+		)
+		return
+	}
+
+	id := d.p.organization
+	if config.ProjectKey.Value != "" {
+		id = config.ProjectKey.Value
+		if config.Branch.Value != "" {
+			id = fmt.Sprintf("%s,%s", config.ProjectKey.Value, config.Branch.Value)
+		}
+	}
+
+	result := DataNewCodePeriod{
+		ID:         types.String{Value: id},
+		ProjectKey: config.ProjectKey,
+		Branch:     config.Branch,
+		Type:       types.String{Value: response.Type},
+		Value:      types.String{Value: response.Value},
+		Inherited:  types.Bool{Value: response.Inherited},
+	}
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
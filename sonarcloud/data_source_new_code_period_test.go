@@ -0,0 +1,36 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNewCodePeriod(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLiveAPI(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNewCodePeriodConfig(projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_new_code_period.test", "project_key", projectKey),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_new_code_period.test", "type"),
+					resource.TestCheckResourceAttrSet("data.sonarcloud_new_code_period.test", "inherited"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNewCodePeriodConfig(projectKey string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_new_code_period" "test" {
+	project_key = "%s"
+}
+`, projectKey)
+}
@@ -0,0 +1,133 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceProjectBranchesType struct{}
+
+func (d dataSourceProjectBranchesType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This data source retrieves the branches of a project.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the project.",
+			},
+			"branches": {
+				Computed:    true,
+				Description: "The branches of the project.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"name": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The name of the branch.",
+					},
+					"is_main": {
+						Type:        types.BoolType,
+						Computed:    true,
+						Description: "Whether this is the main branch of the project.",
+					},
+					"type": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The type of the branch, e.g. `LONG` for long-lived branches.",
+					},
+					"keep_when_inactive": {
+						Type:        types.BoolType,
+						Computed:    true,
+						Description: "Whether the branch is kept when it is inactive, instead of being deleted automatically.",
+					},
+					"quality_gate_status": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The quality gate status of the last analysis of the branch.",
+					},
+					"analysis_date": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The date of the last analysis of the branch.",
+					},
+					"bugs": {
+						Type:        types.Int64Type,
+						Computed:    true,
+						Description: "The number of bugs on the branch.",
+					},
+					"vulnerabilities": {
+						Type:        types.Int64Type,
+						Computed:    true,
+						Description: "The number of vulnerabilities on the branch.",
+					},
+					"code_smells": {
+						Type:        types.Int64Type,
+						Computed:    true,
+						Description: "The number of code smells on the branch.",
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (d dataSourceProjectBranchesType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceProjectBranches{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceProjectBranches struct {
+	p provider
+}
+
+func (d dataSourceProjectBranches) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_project_branches", req.Config)(&resp.Diagnostics)
+
+	var config DataProjectBranches
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, excludedFromPurge, err := listProjectBranches(d.p.client, config.ProjectKey.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the project branches",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result := DataProjectBranches{}
+	allBranches := make([]DataProjectBranch, len(response.Branches))
+	for i, branch := range response.Branches {
+		allBranches[i] = DataProjectBranch{
+			Name:              types.String{Value: branch.Name},
+			IsMain:            types.Bool{Value: branch.IsMain},
+			Type:              types.String{Value: branch.Type},
+			KeepWhenInactive:  types.Bool{Value: excludedFromPurge[branch.Name]},
+			QualityGateStatus: types.String{Value: branch.Status.QualityGateStatus},
+			AnalysisDate:      types.String{Value: branch.AnalysisDate},
+			Bugs:              types.Int64{Value: int64(branch.Status.Bugs)},
+			Vulnerabilities:   types.Int64{Value: int64(branch.Status.Vulnerabilities)},
+			CodeSmells:        types.Int64{Value: int64(branch.Status.CodeSmells)},
+		}
+	}
+	result.Branches = allBranches
+	result.ID = types.String{Value: config.ProjectKey.Value}
+	result.ProjectKey = config.ProjectKey
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,37 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceProjectBranches(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProjectBranchesConfig(projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_project_branches.test", "project_key", projectKey),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarcloud_project_branches.test", "branches.*", map[string]string{
+						"is_main": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceProjectBranchesConfig(projectKey string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_project_branches" "test" {
+	project_key = "%s"
+}
+`, projectKey)
+}
@@ -66,6 +66,8 @@ type dataSourceProjectLinks struct {
 }
 
 func (d dataSourceProjectLinks) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_project_links", req.Config)(&resp.Diagnostics)
+
 	var config DataProjectLinks
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
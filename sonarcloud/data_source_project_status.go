@@ -0,0 +1,213 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/measures"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/qualitygates"
+)
+
+// defaultProjectStatusMetricKeys are the measures that are retrieved if no metric keys are configured
+var defaultProjectStatusMetricKeys = []string{"coverage", "bugs", "vulnerabilities", "duplicated_lines_density"}
+
+type dataSourceProjectStatusType struct{}
+
+func (d dataSourceProjectStatusType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This data source retrieves the quality gate status and measures of the last analysis of a project, " +
+			"or of one of its branches or pull requests.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the project.",
+			},
+			"branch": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of the branch. If omitted, the main branch is used. Conflicts with `pull_request`.",
+			},
+			"pull_request": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The ID of the pull request. Conflicts with `branch`.",
+			},
+			"metric_keys": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+				Description: "The keys of the metrics to retrieve the measures for. " +
+					"Defaults to `coverage`, `bugs`, `vulnerabilities` and `duplicated_lines_density`.",
+			},
+			"quality_gate_status": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The quality gate status. One of: OK, WARN, ERROR, NONE.",
+			},
+			"conditions": {
+				Computed:    true,
+				Description: "The conditions of the quality gate, with the value they were evaluated against.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"metric": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The metric on which the condition is based.",
+					},
+					"op": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "Operation on which the metric is evaluated, e.g. LT or GT.",
+					},
+					"error": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The value on which the condition errors.",
+					},
+					"actual_value": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The value of the metric in the last analysis.",
+					},
+					"status": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The status of the condition. One of: OK, WARN, ERROR.",
+					},
+				}),
+			},
+			"measures": {
+				Type:        types.MapType{ElemType: types.StringType},
+				Computed:    true,
+				Description: "The measures of the last analysis, by metric key. Metrics without a measure are omitted.",
+			},
+		},
+	}, nil
+}
+
+func (d dataSourceProjectStatusType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceProjectStatus{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceProjectStatus struct {
+	p provider
+}
+
+func (d dataSourceProjectStatus) ValidateConfig(ctx context.Context, req tfsdk.ValidateDataSourceConfigRequest, resp *tfsdk.ValidateDataSourceConfigResponse) {
+	var config DataProjectStatus
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Branch.Null && !config.PullRequest.Null {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pull_request"),
+			"Invalid project status configuration",
+			"Only one of `branch` or `pull_request` can be set.",
+		)
+	}
+}
+
+func (d dataSourceProjectStatus) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_project_status", req.Config)(&resp.Diagnostics)
+
+	var config DataProjectStatus
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := qualitygates.ProjectStatusRequest{
+		Branch:      config.Branch.Value,
+		ProjectKey:  config.ProjectKey.Value,
+		PullRequest: config.PullRequest.Value,
+	}
+	response, err := d.p.client.Qualitygates.ProjectStatus(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the project status",
+			fmt.Sprintf("The ProjectStatus request returned an error: %+v", err),
+		)
+		return
+	}
+
+	conditions := make([]ProjectStatusCondition, len(response.ProjectStatus.Conditions))
+	for i, condition := range response.ProjectStatus.Conditions {
+		conditions[i] = ProjectStatusCondition{
+			Metric:      types.String{Value: condition.MetricKey},
+			Op:          types.String{Value: condition.Comparator},
+			Error:       types.String{Value: condition.ErrorThreshold},
+			ActualValue: types.String{Value: condition.ActualValue},
+			Status:      types.String{Value: condition.Status},
+		}
+	}
+
+	metricKeys := defaultProjectStatusMetricKeys
+	if !config.MetricKeys.Null {
+		diags = config.MetricKeys.ElementsAs(ctx, &metricKeys, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	measuresResponse, err := d.p.client.Measures.Component(measures.ComponentRequest{
+		Branch:      config.Branch.Value,
+		Component:   config.ProjectKey.Value,
+		MetricKeys:  strings.Join(metricKeys, ","),
+		PullRequest: config.PullRequest.Value,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the project measures",
+			fmt.Sprintf("The Component request returned an error: %+v", err),
+		)
+		return
+	}
+
+	measureValues := make(map[string]attr.Value, len(measuresResponse.Component.Measures))
+	for _, measure := range measuresResponse.Component.Measures {
+		measureValues[measure.Metric] = types.String{Value: measure.Value}
+	}
+
+	result := DataProjectStatus{
+		ID:                types.String{Value: projectStatusID(config)},
+		ProjectKey:        config.ProjectKey,
+		Branch:            config.Branch,
+		PullRequest:       config.PullRequest,
+		MetricKeys:        config.MetricKeys,
+		QualityGateStatus: types.String{Value: response.ProjectStatus.Status},
+		Conditions:        conditions,
+		Measures:          types.Map{ElemType: types.StringType, Elems: measureValues},
+	}
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
+
+// projectStatusID returns the ID of the project status, which includes the branch or pull request if set
+func projectStatusID(config DataProjectStatus) string {
+	switch {
+	case config.Branch.Value != "":
+		return fmt.Sprintf("%s,%s", config.ProjectKey.Value, config.Branch.Value)
+	case config.PullRequest.Value != "":
+		return fmt.Sprintf("%s,%s", config.ProjectKey.Value, config.PullRequest.Value)
+	default:
+		return config.ProjectKey.Value
+	}
+}
@@ -0,0 +1,46 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceProjectStatus(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLiveAPI(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProjectStatusConfig(projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_project_status.test", "id", projectKey),
+					resource.TestMatchResourceAttr("data.sonarcloud_project_status.test", "quality_gate_status", regexp.MustCompile("^(OK|WARN|ERROR|NONE)$")),
+				),
+			},
+			{
+				Config: `
+data "sonarcloud_project_status" "test" {
+	project_key  = "example"
+	branch       = "main"
+	pull_request = "1"
+}
+`,
+				ExpectError: regexp.MustCompile("Only one of `branch` or `pull_request` can be set"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceProjectStatusConfig(projectKey string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_project_status" "test" {
+	project_key = "%s"
+}
+`, projectKey)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
 )
 
@@ -19,6 +20,11 @@ func (d dataSourceProjectsType) GetSchema(_ context.Context) (tfsdk.Schema, diag
 				Type:     types.StringType,
 				Computed: true,
 			},
+			"tag": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Only return projects with this tag.",
+			},
 			"projects": {
 				Computed:    true,
 				Description: "The projects of this organization.",
@@ -59,8 +65,23 @@ type dataSourceProjects struct {
 	p provider
 }
 
-func (d dataSourceProjects) Read(ctx context.Context, _ tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
-	var diags diag.Diagnostics
+func (d dataSourceProjects) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_projects", req.Config)(&resp.Diagnostics)
+
+	var config Projects
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var taggedProjects map[string]struct{}
+	if config.Tag.Value != "" {
+		var ok bool
+		if taggedProjects, ok = d.findTaggedProjects(config.Tag.Value, &resp.Diagnostics); !ok {
+			return
+		}
+	}
 
 	request := projects.SearchRequest{}
 
@@ -74,19 +95,58 @@ func (d dataSourceProjects) Read(ctx context.Context, _ tfsdk.ReadDataSourceRequ
 	}
 
 	result := Projects{}
-	allProjects := make([]Project, len(response.Components))
-	for i, component := range response.Components {
-		allProjects[i] = Project{
+	allProjects := make([]DataProject, 0, len(response.Components))
+	for _, component := range response.Components {
+		if taggedProjects != nil {
+			if _, ok := taggedProjects[component.Key]; !ok {
+				continue
+			}
+		}
+		allProjects = append(allProjects, DataProject{
 			ID:         types.String{Value: component.Name},
 			Name:       types.String{Value: component.Name},
 			Key:        types.String{Value: component.Key},
 			Visibility: types.String{Value: component.Visibility},
-		}
+		})
 	}
 	result.Projects = allProjects
 	result.ID = types.String{Value: d.p.organization}
+	result.Tag = config.Tag
 
 	diags = resp.State.Set(ctx, result)
 
 	resp.Diagnostics.Append(diags...)
 }
+
+// findTaggedProjects returns the keys of the projects that have the given tag
+func (d dataSourceProjects) findTaggedProjects(tag string, diags *diag.Diagnostics) (map[string]struct{}, bool) {
+	request := ProjectSearchProjectsRequest{
+		Filter: fmt.Sprintf("tags = %s", tag),
+	}
+	components, err := sonarcloud.GetAll[ProjectSearchProjectsRequest, ProjectSearchProjectsResponseComponent](d.p.client, "/components/search_projects", request, "components")
+	if err != nil {
+		diags.AddError(
+			"Could not read the projects",
+			fmt.Sprintf("The SearchProjects request returned an error: %+v", err),
+		)
+		return nil, false
+	}
+
+	result := make(map[string]struct{}, len(components))
+	for _, component := range components {
+		result[component.Key] = struct{}{}
+	}
+	return result, true
+}
+
+// ProjectSearchProjectsRequest represents a request to search projects using a filter, e.g. on tags.
+type ProjectSearchProjectsRequest struct {
+	Filter string
+}
+
+// ProjectSearchProjectsResponseComponent represents a project in the search projects response.
+type ProjectSearchProjectsResponseComponent struct {
+	Key  string   `json:"key,omitempty"`
+	Name string   `json:"name,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+}
@@ -1,6 +1,7 @@
 package sonarcloud
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -10,7 +11,7 @@ func TestAccDataSourceProjects(t *testing.T) {
 	numberOfDefaultProjects := "2"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLiveAPI(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -23,6 +24,29 @@ func TestAccDataSourceProjects(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceProjectsTag(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLiveAPI(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceProjectsTagConfig("tag-that-does-not-exist"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_projects.test_projects", "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceProjectsTagConfig(tag string) string {
+	return fmt.Sprintf(`
+data "sonarcloud_projects" "test_projects" {
+	tag = "%s"
+}
+`, tag)
+}
+
 func testAccDataSourceProjectsConfig() string {
 	return `
 data "sonarcloud_projects" "test_projects" {}
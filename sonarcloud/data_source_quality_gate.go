@@ -55,9 +55,6 @@ func (d dataSourceQualityGateType) GetSchema(_ context.Context) (tfsdk.Schema, d
 						Type:        types.StringType,
 						Description: "The metric on which the condition is based. Must be one of: https://docs.sonarqube.org/latest/user-guide/metric-definitions/",
 						Computed:    true,
-						Validators: []tfsdk.AttributeValidator{
-							allowedOptions("security_rating", "ncloc_language_distribution", "test_execution_time", "statements", "lines_to_cover", "quality_gate_details", "new_reliability_remediation_effort", "tests", "security_review_rating", "new_xxx_violations", "conditions_by_line", "new_violations", "ncloc", "duplicated_lines", "test_failures", "test_errors", "reopened_issues", "new_vulnerabilities", "duplicated_lines_density", "test_success_density", "sqale_debt_ratio", "security_hotspots_reviewed", "security_remediation_effort", "covered_conditions_by_line", "classes", "sqale_rating", "xxx_violations", "true_positive_issues", "violations", "new_security_review_rating", "new_security_remediation_effort", "vulnerabilities", "new_uncovered_conditions", "files", "branch_coverage_hits_data", "uncovered_lines", "comment_lines_density", "new_uncovered_lines", "complexity", "cognitive_complexity", "uncovered_conditions", "functions", "new_technical_debt", "new_coverage", "coverage", "new_branch_coverage", "confirmed_issues", "reliability_remediation_effort", "projects", "coverage_line_hits_data", "code_smells", "directories", "lines", "bugs", "line_coverage", "new_line_coverage", "reliability_rating", "duplicated_blocks", "branch_coverage", "new_code_smells", "new_sqale_debt_ratio", "open_issues", "sqale_index", "new_lines_to_cover", "comment_lines", "skipped_tests"),
-						},
 					},
 					"op": {
 						Type:        types.StringType,
@@ -89,7 +86,9 @@ type dataSourceQualityGate struct {
 }
 
 func (d dataSourceQualityGate) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
-	var config QualityGate
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_quality_gate", req.Config)(&resp.Diagnostics)
+
+	var config DataQualityGate
 	_ = req.Config.Get(ctx, &config)
 	if resp.Diagnostics.HasError() {
 		return
@@ -106,7 +105,7 @@ func (d dataSourceQualityGate) Read(ctx context.Context, req tfsdk.ReadDataSourc
 		return
 	}
 
-	result := QualityGate{}
+	result := DataQualityGate{}
 	for _, qualityGate := range response.Qualitygates {
 		if qualityGate.Name == config.Name.Value {
 			for _, condition := range qualityGate.Conditions {
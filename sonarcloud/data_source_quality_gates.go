@@ -64,9 +64,6 @@ func (d dataSourceQualityGatesType) GetSchema(_ context.Context) (tfsdk.Schema,
 								Type:        types.StringType,
 								Description: "The metric on which the condition is based. Must be one of: https://docs.sonarqube.org/latest/user-guide/metric-definitions/",
 								Computed:    true,
-								Validators: []tfsdk.AttributeValidator{
-									allowedOptions("security_rating", "ncloc_language_distribution", "test_execution_time", "statements", "lines_to_cover", "quality_gate_details", "new_reliability_remediation_effort", "tests", "security_review_rating", "new_xxx_violations", "conditions_by_line", "new_violations", "ncloc", "duplicated_lines", "test_failures", "test_errors", "reopened_issues", "new_vulnerabilities", "duplicated_lines_density", "test_success_density", "sqale_debt_ratio", "security_hotspots_reviewed", "security_remediation_effort", "covered_conditions_by_line", "classes", "sqale_rating", "xxx_violations", "true_positive_issues", "violations", "new_security_review_rating", "new_security_remediation_effort", "vulnerabilities", "new_uncovered_conditions", "files", "branch_coverage_hits_data", "uncovered_lines", "comment_lines_density", "new_uncovered_lines", "complexity", "cognitive_complexity", "uncovered_conditions", "functions", "new_technical_debt", "new_coverage", "coverage", "new_branch_coverage", "confirmed_issues", "reliability_remediation_effort", "projects", "coverage_line_hits_data", "code_smells", "directories", "lines", "bugs", "line_coverage", "new_line_coverage", "reliability_rating", "duplicated_blocks", "branch_coverage", "new_code_smells", "new_sqale_debt_ratio", "open_issues", "sqale_index", "new_lines_to_cover", "comment_lines", "skipped_tests"),
-								},
 							},
 							"op": {
 								Type:        types.StringType,
@@ -99,7 +96,9 @@ type dataSourceQualityGates struct {
 	p provider
 }
 
-func (d dataSourceQualityGates) Read(ctx context.Context, _ tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+func (d dataSourceQualityGates) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_quality_gates", req.Config)(&resp.Diagnostics)
+
 	var diags diag.Diagnostics
 
 	request := qualitygates.ListRequest{}
@@ -114,7 +113,7 @@ func (d dataSourceQualityGates) Read(ctx context.Context, _ tfsdk.ReadDataSource
 	}
 
 	result := QualityGates{}
-	allQualityGates := make([]QualityGate, 0, len(response.Qualitygates))
+	allQualityGates := make([]DataQualityGate, 0, len(response.Qualitygates))
 	for _, qualityGate := range response.Qualitygates {
 		var allConditions []Condition
 		for _, condition := range qualityGate.Conditions {
@@ -125,7 +124,7 @@ func (d dataSourceQualityGates) Read(ctx context.Context, _ tfsdk.ReadDataSource
 				Op:     types.String{Value: condition.Op},
 			})
 		}
-		allQualityGates = append(allQualityGates, QualityGate{
+		allQualityGates = append(allQualityGates, DataQualityGate{
 			ID:         types.String{Value: fmt.Sprintf("%d", int(qualityGate.Id))},
 			GateId:     types.Float64{Value: qualityGate.Id},
 			IsBuiltIn:  types.Bool{Value: qualityGate.IsBuiltIn},
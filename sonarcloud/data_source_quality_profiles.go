@@ -0,0 +1,148 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceQualityProfilesType struct{}
+
+func (d dataSourceQualityProfilesType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This data source retrieves the Quality Profiles for the configured organization, including the built-in profiles.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"language": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The key of the language to retrieve the Quality Profiles for, e.g. `java`. If empty, the profiles of all languages are returned.",
+			},
+			"defaults_only": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Description: "If true, only the default Quality Profile of each language is returned.",
+			},
+			"quality_profiles": {
+				Computed:    true,
+				Description: "The Quality Profiles matching the filters.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"key": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The key of the Quality Profile.",
+					},
+					"name": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The name of the Quality Profile.",
+					},
+					"language": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The key of the language of the Quality Profile.",
+					},
+					"language_name": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The name of the language of the Quality Profile.",
+					},
+					"is_default": {
+						Type:        types.BoolType,
+						Computed:    true,
+						Description: "Is this the default Quality Profile for its language?",
+					},
+					"is_built_in": {
+						Type:        types.BoolType,
+						Computed:    true,
+						Description: "Is this Quality Profile built in?",
+					},
+					"is_inherited": {
+						Type:        types.BoolType,
+						Computed:    true,
+						Description: "Does this Quality Profile inherit rules from a parent profile?",
+					},
+					"parent_key": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The key of the parent Quality Profile, if any.",
+					},
+					"active_rule_count": {
+						Type:        types.Int64Type,
+						Computed:    true,
+						Description: "The number of rules that are active in the Quality Profile.",
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (d dataSourceQualityProfilesType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceQualityProfiles{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceQualityProfiles struct {
+	p provider
+}
+
+func (d dataSourceQualityProfiles) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_quality_profiles", req.Config)(&resp.Diagnostics)
+
+	var config DataQualityProfiles
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := make([]string, 0)
+	if config.Language.Value != "" {
+		params = append(params, "language", config.Language.Value)
+	}
+	if config.DefaultsOnly.Value {
+		params = append(params, "defaults", strconv.FormatBool(config.DefaultsOnly.Value))
+	}
+
+	response, err := getWithResponse[QualityProfilesSearchResponse](d.p.client, "/qualityprofiles/search", params...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the Quality Profiles",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result := DataQualityProfiles{}
+	allQualityProfiles := make([]DataQualityProfile, len(response.Profiles))
+	for i, profile := range response.Profiles {
+		allQualityProfiles[i] = DataQualityProfile{
+			Key:             types.String{Value: profile.Key},
+			Name:            types.String{Value: profile.Name},
+			Language:        types.String{Value: profile.Language},
+			LanguageName:    types.String{Value: profile.LanguageName},
+			IsDefault:       types.Bool{Value: profile.IsDefault},
+			IsBuiltIn:       types.Bool{Value: profile.IsBuiltIn},
+			IsInherited:     types.Bool{Value: profile.IsInherited},
+			ParentKey:       types.String{Value: profile.ParentKey},
+			ActiveRuleCount: types.Int64{Value: int64(profile.ActiveRuleCount)},
+		}
+	}
+	result.QualityProfiles = allQualityProfiles
+	result.ID = types.String{Value: d.p.organization}
+	result.Language = config.Language
+	result.DefaultsOnly = config.DefaultsOnly
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
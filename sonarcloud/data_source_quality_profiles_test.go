@@ -0,0 +1,33 @@
+package sonarcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceQualityProfiles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLiveAPI(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceQualityProfilesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarcloud_quality_profiles.test_profiles", "quality_profiles.#", "1"),
+					resource.TestCheckResourceAttr("data.sonarcloud_quality_profiles.test_profiles", "quality_profiles.0.language", "java"),
+					resource.TestCheckResourceAttr("data.sonarcloud_quality_profiles.test_profiles", "quality_profiles.0.is_default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceQualityProfilesConfig() string {
+	return `
+data "sonarcloud_quality_profiles" "test_profiles" {
+	language      = "java"
+	defaults_only = true
+}
+`
+}
@@ -0,0 +1,178 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/rules"
+)
+
+type dataSourceRulesType struct{}
+
+func (d dataSourceRulesType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This data source retrieves the rules that are available in SonarCloud, optionally filtered.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"language": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Only return rules of this language, e.g. `java`.",
+			},
+			"repository": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Only return rules of this repository, e.g. `java` or `javasecurity`.",
+			},
+			"type": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Only return rules of this type. Must be one of: CODE_SMELL, BUG, VULNERABILITY, SECURITY_HOTSPOT.",
+				Validators: []tfsdk.AttributeValidator{
+					allowedOptions("CODE_SMELL", "BUG", "VULNERABILITY", "SECURITY_HOTSPOT"),
+				},
+			},
+			"severity": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Only return rules with this default severity. Must be one of: INFO, MINOR, MAJOR, CRITICAL, BLOCKER.",
+				Validators: []tfsdk.AttributeValidator{
+					allowedOptions("INFO", "MINOR", "MAJOR", "CRITICAL", "BLOCKER"),
+				},
+			},
+			"tag": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "Only return rules with this tag, e.g. `cwe`.",
+			},
+			"rules": {
+				Computed:    true,
+				Description: "The rules matching the filters.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"key": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The key of the rule.",
+					},
+					"name": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The name of the rule.",
+					},
+					"language": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The key of the language of the rule.",
+					},
+					"repository": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The repository of the rule.",
+					},
+					"type": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The type of the rule.",
+					},
+					"severity": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The default severity of the rule.",
+					},
+					"status": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "The status of the rule, e.g. `READY` or `DEPRECATED`.",
+					},
+					"tags": {
+						Type:        types.SetType{ElemType: types.StringType},
+						Computed:    true,
+						Description: "The system and custom tags of the rule.",
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (d dataSourceRulesType) NewDataSource(_ context.Context, p tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return dataSourceRules{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type dataSourceRules struct {
+	p provider
+}
+
+func (d dataSourceRules) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_rules", req.Config)(&resp.Diagnostics)
+
+	var config DataRules
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := rules.SearchRequest{
+		F:            "name,lang,repo,type,severity,status,sysTags,tags",
+		Languages:    config.Language.Value,
+		Repositories: config.Repository.Value,
+		Severities:   config.Severity.Value,
+		Tags:         config.Tag.Value,
+		Types:        config.Type.Value,
+	}
+
+	response, err := d.p.client.Rules.SearchAll(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the rules",
+			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result := DataRules{}
+	allRules := make([]DataRule, len(response.Rules))
+	for i, rule := range response.Rules {
+		// A tag can be both a system tag and a custom tag, but must only be in the set once
+		tags := make([]attr.Value, 0, len(rule.SysTags)+len(rule.Tags))
+		for _, ruleTags := range [][]string{rule.SysTags, rule.Tags} {
+			for _, tag := range ruleTags {
+				if !stringAttributesContain(tags, tag) {
+					tags = append(tags, types.String{Value: tag})
+				}
+			}
+		}
+
+		allRules[i] = DataRule{
+			Key:        types.String{Value: rule.Key},
+			Name:       types.String{Value: rule.Name},
+			Language:   types.String{Value: rule.Lang},
+			Repository: types.String{Value: rule.Repo},
+			Type:       types.String{Value: rule.Type},
+			Severity:   types.String{Value: rule.Severity},
+			Status:     types.String{Value: rule.Status},
+			Tags:       types.Set{ElemType: types.StringType, Elems: tags},
+		}
+	}
+	result.Rules = allRules
+	result.ID = types.String{Value: d.p.organization}
+	result.Language = config.Language
+	result.Repository = config.Repository
+	result.Type = config.Type
+	result.Severity = config.Severity
+	result.Tag = config.Tag
+
+	diags = resp.State.Set(ctx, result)
+
+	resp.Diagnostics.Append(diags...)
+}
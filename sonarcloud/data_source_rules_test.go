@@ -0,0 +1,35 @@
+package sonarcloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRules(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLiveAPI(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRulesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarcloud_rules.test_rules", "rules.*", map[string]string{
+						"key":      "java:S2068",
+						"language": "java",
+						"type":     "SECURITY_HOTSPOT",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRulesConfig() string {
+	return `
+data "sonarcloud_rules" "test_rules" {
+	language = "java"
+	type     = "SECURITY_HOTSPOT"
+}
+`
+}
@@ -55,6 +55,8 @@ type dataSourceUserGroup struct {
 }
 
 func (d dataSourceUserGroup) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_user_group", req.Config)(&resp.Diagnostics)
+
 	// Retrieve values from config
 	var config Group
 	diags := req.Config.Get(ctx, &config)
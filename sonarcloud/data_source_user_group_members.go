@@ -55,6 +55,8 @@ type dataSourceUserGroupMembers struct {
 }
 
 func (d dataSourceUserGroupMembers) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_user_group_members", req.Config)(&resp.Diagnostics)
+
 	var config Users
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
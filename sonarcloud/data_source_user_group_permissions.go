@@ -67,6 +67,8 @@ type dataSourceUserGroupPermissions struct {
 }
 
 func (d dataSourceUserGroupPermissions) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_user_group_permissions", req.Config)(&resp.Diagnostics)
+
 	var config DataUserGroupPermissions
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -66,7 +66,9 @@ type dataSourceUserGroups struct {
 	p provider
 }
 
-func (d dataSourceUserGroups) Read(ctx context.Context, _ tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+func (d dataSourceUserGroups) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_user_groups", req.Config)(&resp.Diagnostics)
+
 	var diags diag.Diagnostics
 
 	request := user_groups.SearchRequest{}
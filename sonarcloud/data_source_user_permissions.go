@@ -70,6 +70,8 @@ type dataSourceUserPermissions struct {
 }
 
 func (d dataSourceUserPermissions) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_user_permissions", req.Config)(&resp.Diagnostics)
+
 	var config DataUserPermissions
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
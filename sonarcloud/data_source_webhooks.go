@@ -66,6 +66,8 @@ type dataSourceWebhooks struct {
 }
 
 func (d dataSourceWebhooks) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	defer logDataSourceOperation(ctx, d.p, "sonarcloud_webhooks", req.Config)(&resp.Diagnostics)
+
 	var config DataWebhooks
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,1796 @@
+package sonarcloud
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // Only used to derive fake avatar hashes, like the gravatar hashes of the API
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/metrics"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/paging"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/permissions"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/qualitygates"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/user_groups"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/user_tokens"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/webhooks"
+)
+
+// Fixtures of the fake SonarCloud API, which take the place of the preconfigured test organization
+const (
+	fakeOrganization    = "fake-organization"
+	fakeToken           = "fake-token"
+	fakeAdminLogin      = "fake-admin@github"
+	fakeUserLogin       = "fake-user@github"
+	fakeTestGroupName   = "TEST_DONT_REMOVE"
+	fakeProjectKey      = "fake-project"
+	fakeProjectBranch   = "release-1.0"
+	fakeSonarWayGateID  = 9
+	fakeSonarWayName    = "Sonar way"
+	fakeAnalysisDate    = "2024-01-01T00:00:00+0000"
+	fakeAnyoneGroupID   = 0
+	fakeAnyoneGroupName = "Anyone"
+)
+
+// fakeGlobalPermissions and fakeProjectPermissions are the permissions that can be granted on the organization and on projects
+var (
+	fakeGlobalPermissions  = []string{"admin", "gateadmin", "profileadmin", "provisioning", "scan"}
+	fakeProjectPermissions = []string{"admin", "codeviewer", "issueadmin", "scan", "securityhotspotadmin", "user"}
+)
+
+// fakeMetrics are the metrics that quality gate conditions can be based on
+var fakeMetrics = []fakeObject{
+	{"key": "bugs", "name": "Bugs", "type": "INT", "domain": "Reliability", "direction": -1},
+	{"key": "coverage", "name": "Coverage", "type": "PERCENT", "domain": "Coverage", "direction": 1},
+	{"key": "duplicated_lines_density", "name": "Duplicated Lines (%)", "type": "PERCENT", "domain": "Duplications", "direction": -1},
+	{"key": "new_bugs", "name": "New Bugs", "type": "INT", "domain": "Reliability", "direction": -1},
+	{"key": "new_coverage", "name": "Coverage on New Code", "type": "PERCENT", "domain": "Coverage", "direction": 1},
+	{"key": "new_duplicated_lines_density", "name": "Duplicated Lines on New Code (%)", "type": "PERCENT", "domain": "Duplications", "direction": -1},
+	{"key": "new_maintainability_rating", "name": "Maintainability Rating on New Code", "type": "RATING", "domain": "Maintainability", "direction": -1},
+	{"key": "new_reliability_rating", "name": "Reliability Rating on New Code", "type": "RATING", "domain": "Reliability", "direction": -1},
+	{"key": "new_security_hotspots_reviewed", "name": "Security Hotspots Reviewed on New Code", "type": "PERCENT", "domain": "SecurityReview", "direction": 1},
+	{"key": "new_security_rating", "name": "Security Rating on New Code", "type": "RATING", "domain": "Security", "direction": -1},
+	{"key": "new_technical_debt", "name": "Added Technical Debt", "type": "WORK_DUR", "domain": "Maintainability", "direction": -1},
+}
+
+// fakeSonarCloud is an in-memory implementation of the parts of the SonarCloud API that the provider uses.
+// The acceptance tests run against it when SONARCLOUD_TOKEN is not set, see TestMain.
+type fakeSonarCloud struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	lastID      int
+	users       []fakeUser
+	groups      []*fakeGroup
+	projects    map[string]*fakeProject
+	gates       []*fakeQualityGate
+	defaultGate int
+	webhooks    []*fakeWebhook
+	tokens      map[string][]fakeUserToken
+	permissions fakePermissions
+	settings    map[string]fakeSetting
+}
+
+type fakeUser struct {
+	login string
+	name  string
+}
+
+type fakeGroup struct {
+	id          int
+	name        string
+	description string
+	isDefault   bool
+	members     map[string]bool
+}
+
+type fakeProject struct {
+	id          int
+	key         string
+	name        string
+	visibility  string
+	tags        []string
+	branches    []*fakeBranch
+	links       []fakeLink
+	settings    map[string]fakeSetting
+	permissions fakePermissions
+	gateID      int
+}
+
+type fakeBranch struct {
+	name         string
+	isMain       bool
+	analysisDate string
+	protected    bool
+}
+
+type fakeLink struct {
+	id   string
+	name string
+	url  string
+}
+
+type fakeSetting struct {
+	value       string
+	values      []string
+	fieldValues []map[string]string
+}
+
+// fakePermissions holds the permissions of groups by their ID and of users by their login
+type fakePermissions struct {
+	groups map[int]map[string]bool
+	users  map[string]map[string]bool
+}
+
+type fakeQualityGate struct {
+	id         int
+	name       string
+	isBuiltIn  bool
+	conditions []fakeCondition
+}
+
+type fakeCondition struct {
+	id        int
+	metric    string
+	op        string
+	threshold string
+}
+
+type fakeWebhook struct {
+	key     string
+	name    string
+	url     string
+	secret  string
+	project string
+}
+
+type fakeUserToken struct {
+	name      string
+	createdAt string
+}
+
+// fakeObject is a JSON object in a response of the fake API
+type fakeObject map[string]interface{}
+
+// fakeHandler handles a request with the given query and form parameters.
+// A nil response is sent as an empty response, and errors are sent like the API does.
+type fakeHandler func(params url.Values) (interface{}, error)
+
+type fakeRoute struct {
+	method string
+	handle fakeHandler
+}
+
+// fakeAPIError is an error response of the fake API
+type fakeAPIError struct {
+	status  int
+	message string
+}
+
+func (e fakeAPIError) Error() string {
+	return e.message
+}
+
+func fakeBadRequest(format string, args ...interface{}) error {
+	return fakeAPIError{status: http.StatusBadRequest, message: fmt.Sprintf(format, args...)}
+}
+
+func fakeNotFound(format string, args ...interface{}) error {
+	return fakeAPIError{status: http.StatusNotFound, message: fmt.Sprintf(format, args...)}
+}
+
+// newFakeSonarCloud starts a fake SonarCloud API with the fixtures that the acceptance tests expect
+func newFakeSonarCloud() *fakeSonarCloud {
+	f := &fakeSonarCloud{
+		// Leave room for the ID of the built-in quality gate
+		lastID:      100,
+		projects:    map[string]*fakeProject{},
+		tokens:      map[string][]fakeUserToken{},
+		permissions: newFakePermissions(),
+		settings:    map[string]fakeSetting{},
+		defaultGate: fakeSonarWayGateID,
+	}
+	f.seed()
+
+	mux := http.NewServeMux()
+	for path, route := range f.routes() {
+		mux.Handle("/api"+path, f.handler(route))
+	}
+	mux.Handle("/", f.handler(fakeRoute{handle: func(_ url.Values) (interface{}, error) {
+		return nil, fakeNotFound("Unknown url")
+	}}))
+
+	f.Server = httptest.NewServer(mux)
+	return f
+}
+
+// setEnv points the acceptance tests at the fake API and its fixtures
+func (f *fakeSonarCloud) setEnv() error {
+	env := map[string]string{
+		"SONARCLOUD_BASE_URL":              f.URL,
+		"SONARCLOUD_ORGANIZATION":          fakeOrganization,
+		"SONARCLOUD_TOKEN":                 fakeToken,
+		"SONARCLOUD_TEST_USER_LOGIN":       fakeUserLogin,
+		"SONARCLOUD_TEST_GROUP_NAME":       fakeTestGroupName,
+		"SONARCLOUD_TOKEN_TEST_USER_LOGIN": fakeAdminLogin,
+		"SONARCLOUD_PROJECT_KEY":           fakeProjectKey,
+		"SONARCLOUD_PROJECT_BRANCH":        fakeProjectBranch,
+		"SONARCLOUD_QUALITY_GATE_ID":       strconv.Itoa(fakeSonarWayGateID),
+		"SONARCLOUD_QUALITY_GATE_NAME":     fakeSonarWayName,
+	}
+	for key, value := range env {
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeSonarCloud) seed() {
+	f.users = []fakeUser{
+		{login: fakeAdminLogin, name: "Fake Admin"},
+		{login: fakeUserLogin, name: "Fake User"},
+	}
+
+	members := f.addGroup("Members", "All members of the organization")
+	members.isDefault = true
+	members.members[fakeAdminLogin] = true
+	members.members[fakeUserLogin] = true
+	owners := f.addGroup("Owners", "Owners of the organization")
+	owners.members[fakeAdminLogin] = true
+	f.addGroup(fakeTestGroupName, "")
+
+	f.permissions.grantGroup(owners.id, "admin")
+	f.permissions.grantGroup(members.id, "scan")
+	f.permissions.grantUser(fakeAdminLogin, "admin")
+
+	project := f.addProject(fakeProjectKey, "Fake Project", "public")
+	project.branches[0].analysisDate = fakeAnalysisDate
+	project.branches = append(project.branches, &fakeBranch{name: fakeProjectBranch, analysisDate: fakeAnalysisDate})
+	project.permissions.grantGroup(owners.id, "admin")
+
+	f.gates = []*fakeQualityGate{{
+		id:        fakeSonarWayGateID,
+		name:      fakeSonarWayName,
+		isBuiltIn: true,
+		conditions: []fakeCondition{
+			{id: f.nextID(), metric: "new_reliability_rating", op: "GT", threshold: "1"},
+			{id: f.nextID(), metric: "new_security_rating", op: "GT", threshold: "1"},
+			{id: f.nextID(), metric: "new_maintainability_rating", op: "GT", threshold: "1"},
+			{id: f.nextID(), metric: "new_coverage", op: "LT", threshold: "80"},
+			{id: f.nextID(), metric: "new_duplicated_lines_density", op: "GT", threshold: "3"},
+			{id: f.nextID(), metric: "new_security_hotspots_reviewed", op: "LT", threshold: "100"},
+		},
+	}}
+}
+
+func (f *fakeSonarCloud) routes() map[string]fakeRoute {
+	return map[string]fakeRoute{
+		"/components/show": {http.MethodGet, f.showComponent},
+
+		"/metrics/search": {http.MethodGet, f.searchMetrics},
+
+		"/permissions/add_group":    {http.MethodPost, f.addGroupPermission},
+		"/permissions/add_user":     {http.MethodPost, f.addUserPermission},
+		"/permissions/groups":       {http.MethodGet, f.searchGroupPermissions},
+		"/permissions/remove_group": {http.MethodPost, f.removeGroupPermission},
+		"/permissions/remove_user":  {http.MethodPost, f.removeUserPermission},
+		"/permissions/users":        {http.MethodGet, f.searchUserPermissions},
+
+		"/project_branches/delete":                            {http.MethodPost, f.deleteBranch},
+		"/project_branches/list":                              {http.MethodGet, f.listBranches},
+		"/project_branches/rename":                            {http.MethodPost, f.renameMainBranch},
+		"/project_branches/set_automatic_deletion_protection": {http.MethodPost, f.protectBranch},
+
+		"/project_links/create": {http.MethodPost, f.createLink},
+		"/project_links/delete": {http.MethodPost, f.deleteLink},
+		"/project_links/search": {http.MethodGet, f.searchLinks},
+		"/project_tags/set":     {http.MethodPost, f.setTags},
+
+		"/projects/create":            {http.MethodPost, f.createProject},
+		"/projects/delete":            {http.MethodPost, f.deleteProject},
+		"/projects/search":            {http.MethodGet, f.searchProjects},
+		"/projects/update_key":        {http.MethodPost, f.updateProjectKey},
+		"/projects/update_visibility": {http.MethodPost, f.updateProjectVisibility},
+
+		"/qualitygates/copy":             {http.MethodPost, f.copyGate},
+		"/qualitygates/create":           {http.MethodPost, f.createGate},
+		"/qualitygates/create_condition": {http.MethodPost, f.createCondition},
+		"/qualitygates/delete_condition": {http.MethodPost, f.deleteCondition},
+		"/qualitygates/deselect":         {http.MethodPost, f.deselectGate},
+		"/qualitygates/destroy":          {http.MethodPost, f.destroyGate},
+		"/qualitygates/list":             {http.MethodGet, f.listGates},
+		"/qualitygates/rename":           {http.MethodPost, f.renameGate},
+		"/qualitygates/search":           {http.MethodGet, f.searchGateProjects},
+		"/qualitygates/select":           {http.MethodPost, f.selectGate},
+		"/qualitygates/set_as_default":   {http.MethodPost, f.setDefaultGate},
+		"/qualitygates/update_condition": {http.MethodPost, f.updateCondition},
+
+		"/settings/reset":  {http.MethodPost, f.resetSettings},
+		"/settings/set":    {http.MethodPost, f.setSetting},
+		"/settings/values": {http.MethodGet, f.settingValues},
+
+		"/user_groups/add_user":    {http.MethodPost, f.addGroupMember},
+		"/user_groups/create":      {http.MethodPost, f.createGroup},
+		"/user_groups/delete":      {http.MethodPost, f.deleteGroup},
+		"/user_groups/remove_user": {http.MethodPost, f.removeGroupMember},
+		"/user_groups/search":      {http.MethodGet, f.searchGroups},
+		"/user_groups/update":      {http.MethodPost, f.updateGroup},
+		"/user_groups/users":       {http.MethodGet, f.groupMembers},
+
+		"/user_tokens/generate": {http.MethodPost, f.generateToken},
+		"/user_tokens/revoke":   {http.MethodPost, f.revokeToken},
+		"/user_tokens/search":   {http.MethodGet, f.searchTokens},
+
+		"/webhooks/create": {http.MethodPost, f.createWebhook},
+		"/webhooks/delete": {http.MethodPost, f.deleteWebhook},
+		"/webhooks/list":   {http.MethodGet, f.listWebhooks},
+		"/webhooks/update": {http.MethodPost, f.updateWebhook},
+	}
+}
+
+// handler authenticates the request and sends the response of the route, while holding the lock on the state
+func (f *fakeSonarCloud) handler(route fakeRoute) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, err := f.handle(route, r)
+		if err != nil {
+			status := http.StatusInternalServerError
+			var apiErr fakeAPIError
+			if errors.As(err, &apiErr) {
+				status = apiErr.status
+			}
+			writeFakeJSON(w, status, fakeObject{"errors": []fakeObject{{"msg": err.Error()}}})
+			return
+		}
+		if response == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeFakeJSON(w, http.StatusOK, response)
+	})
+}
+
+func (f *fakeSonarCloud) handle(route fakeRoute, r *http.Request) (interface{}, error) {
+	if token, _, ok := r.BasicAuth(); !ok || token != fakeToken {
+		return nil, fakeAPIError{status: http.StatusUnauthorized, message: "Authentication is required"}
+	}
+	if route.method != "" && r.Method != route.method {
+		return nil, fakeAPIError{status: http.StatusMethodNotAllowed, message: fmt.Sprintf("HTTP method %s is not supported", r.Method)}
+	}
+	if err := r.ParseForm(); err != nil {
+		return nil, fakeBadRequest("Invalid parameters: %s", err)
+	}
+	if organization := r.Form.Get("organization"); organization != "" && organization != fakeOrganization {
+		return nil, fakeNotFound("No organization with key '%s'", organization)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return route.handle(r.Form)
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// required returns the values of the given parameters, or an error for the first one that is missing
+func required(params url.Values, names ...string) ([]string, error) {
+	values := make([]string, len(names))
+	for i, name := range names {
+		values[i] = params.Get(name)
+		if values[i] == "" {
+			return nil, fakeBadRequest("The '%s' parameter is missing", name)
+		}
+	}
+	return values, nil
+}
+
+// fakePage returns the page of the items that is requested with the p and ps parameters
+func fakePage[T any](items []T, params url.Values) ([]T, paging.Paging) {
+	page, size := 1, 100
+	if p, err := strconv.Atoi(params.Get("p")); err == nil && p > 0 {
+		page = p
+	}
+	if ps, err := strconv.Atoi(params.Get("ps")); err == nil && ps > 0 {
+		size = ps
+	}
+
+	start := min((page-1)*size, len(items))
+	end := min(start+size, len(items))
+	return items[start:end], paging.Paging{PageIndex: page, PageSize: size, Total: len(items)}
+}
+
+func (f *fakeSonarCloud) nextID() int {
+	f.lastID++
+	return f.lastID
+}
+
+func newFakePermissions() fakePermissions {
+	return fakePermissions{
+		groups: map[int]map[string]bool{},
+		users:  map[string]map[string]bool{},
+	}
+}
+
+func (p fakePermissions) grantGroup(id int, permission string) {
+	if p.groups[id] == nil {
+		p.groups[id] = map[string]bool{}
+	}
+	p.groups[id][permission] = true
+}
+
+func (p fakePermissions) grantUser(login, permission string) {
+	if p.users[login] == nil {
+		p.users[login] = map[string]bool{}
+	}
+	p.users[login][permission] = true
+}
+
+// sortedKeys returns the permissions in the order of the API
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Projects
+
+func (f *fakeSonarCloud) addProject(key, name, visibility string) *fakeProject {
+	project := &fakeProject{
+		id:          f.nextID(),
+		key:         key,
+		name:        name,
+		visibility:  visibility,
+		tags:        []string{},
+		branches:    []*fakeBranch{{name: "main", isMain: true, protected: true}},
+		settings:    map[string]fakeSetting{},
+		permissions: newFakePermissions(),
+	}
+	f.projects[key] = project
+	return project
+}
+
+func (f *fakeSonarCloud) project(key string) (*fakeProject, error) {
+	project, ok := f.projects[key]
+	if !ok {
+		return nil, fakeNotFound("Project '%s' not found", key)
+	}
+	return project, nil
+}
+
+func (f *fakeSonarCloud) projectParam(params url.Values, name string) (*fakeProject, error) {
+	values, err := required(params, name)
+	if err != nil {
+		return nil, err
+	}
+	return f.project(values[0])
+}
+
+func (f *fakeSonarCloud) sortedProjects() []*fakeProject {
+	projects := make([]*fakeProject, 0, len(f.projects))
+	for _, project := range f.projects {
+		projects = append(projects, project)
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].key < projects[j].key })
+	return projects
+}
+
+func validVisibility(visibility string) error {
+	if visibility != "public" && visibility != "private" {
+		return fakeBadRequest("Value of parameter 'visibility' (%s) must be one of: [private, public]", visibility)
+	}
+	return nil
+}
+
+func (f *fakeSonarCloud) createProject(params url.Values) (interface{}, error) {
+	values, err := required(params, "project", "name")
+	if err != nil {
+		return nil, err
+	}
+	key, name := values[0], values[1]
+	if _, ok := f.projects[key]; ok {
+		return nil, fakeBadRequest("Could not create Project, key already exists: %s", key)
+	}
+
+	visibility := params.Get("visibility")
+	if visibility == "" {
+		visibility = "public"
+	}
+	if err := validVisibility(visibility); err != nil {
+		return nil, err
+	}
+
+	f.addProject(key, name, visibility)
+	return fakeObject{"project": fakeObject{"key": key, "name": name, "qualifier": "TRK"}}, nil
+}
+
+func (f *fakeSonarCloud) deleteProject(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "project")
+	if err != nil {
+		return nil, err
+	}
+
+	delete(f.projects, project.key)
+	webhooks := f.webhooks[:0]
+	for _, webhook := range f.webhooks {
+		if webhook.project != project.key {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	f.webhooks = webhooks
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) searchProjects(params url.Values) (interface{}, error) {
+	var keys map[string]bool
+	if projects := params.Get("projects"); projects != "" {
+		keys = map[string]bool{}
+		for _, key := range strings.Split(projects, ",") {
+			keys[key] = true
+		}
+	}
+	q := strings.ToLower(params.Get("q"))
+
+	components := make([]fakeObject, 0)
+	for _, project := range f.sortedProjects() {
+		if keys != nil && !keys[project.key] {
+			continue
+		}
+		if q != "" && !strings.Contains(strings.ToLower(project.key), q) && !strings.Contains(strings.ToLower(project.name), q) {
+			continue
+		}
+		components = append(components, fakeObject{
+			"key":          project.key,
+			"name":         project.name,
+			"organization": fakeOrganization,
+			"qualifier":    "TRK",
+			"visibility":   project.visibility,
+		})
+	}
+
+	page, pager := fakePage(components, params)
+	return fakeObject{"components": page, "paging": pager}, nil
+}
+
+func (f *fakeSonarCloud) updateProjectKey(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "from")
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "to")
+	if err != nil {
+		return nil, err
+	}
+	to := values[0]
+	if _, ok := f.projects[to]; ok {
+		return nil, fakeBadRequest("Impossible to update key: a component with key \"%s\" already exists.", to)
+	}
+
+	delete(f.projects, project.key)
+	for _, webhook := range f.webhooks {
+		if webhook.project == project.key {
+			webhook.project = to
+		}
+	}
+	project.key = to
+	f.projects[to] = project
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) updateProjectVisibility(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "project")
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "visibility")
+	if err != nil {
+		return nil, err
+	}
+	if err := validVisibility(values[0]); err != nil {
+		return nil, err
+	}
+
+	project.visibility = values[0]
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) setTags(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "project")
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]string, 0)
+	for _, tag := range strings.Split(params.Get("tags"), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	project.tags = tags
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) showComponent(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "component")
+	if err != nil {
+		return nil, err
+	}
+
+	return fakeObject{"component": fakeObject{
+		"key":        project.key,
+		"name":       project.name,
+		"qualifier":  "TRK",
+		"visibility": project.visibility,
+		"tags":       project.tags,
+	}}, nil
+}
+
+// Branches
+
+func (p *fakeProject) branch(name string) (*fakeBranch, error) {
+	for _, branch := range p.branches {
+		if branch.name == name {
+			return branch, nil
+		}
+	}
+	return nil, fakeNotFound("Branch '%s' not found for project '%s'", name, p.key)
+}
+
+func (f *fakeSonarCloud) listBranches(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "project")
+	if err != nil {
+		return nil, err
+	}
+
+	branches := make([]fakeObject, len(project.branches))
+	for i, branch := range project.branches {
+		branches[i] = fakeObject{
+			"name":              branch.name,
+			"isMain":            branch.isMain,
+			"type":              "LONG",
+			"excludedFromPurge": branch.protected,
+		}
+		if branch.analysisDate != "" {
+			branches[i]["analysisDate"] = branch.analysisDate
+			branches[i]["status"] = fakeObject{"qualityGateStatus": "OK"}
+		}
+	}
+	return fakeObject{"branches": branches}, nil
+}
+
+func (f *fakeSonarCloud) deleteBranch(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "project")
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "branch")
+	if err != nil {
+		return nil, err
+	}
+	branch, err := project.branch(values[0])
+	if err != nil {
+		return nil, err
+	}
+	if branch.isMain {
+		return nil, fakeBadRequest("Only non-main branches can be deleted")
+	}
+
+	branches := project.branches[:0]
+	for _, b := range project.branches {
+		if b != branch {
+			branches = append(branches, b)
+		}
+	}
+	project.branches = branches
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) renameMainBranch(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "project")
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "name")
+	if err != nil {
+		return nil, err
+	}
+	name := values[0]
+
+	for _, branch := range project.branches {
+		if branch.name == name && !branch.isMain {
+			return nil, fakeBadRequest("Impossible to update branch name: a branch with name \"%s\" already exists in the project.", name)
+		}
+	}
+	for _, branch := range project.branches {
+		if branch.isMain {
+			branch.name = name
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) protectBranch(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "project")
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "branch", "value")
+	if err != nil {
+		return nil, err
+	}
+	branch, err := project.branch(values[0])
+	if err != nil {
+		return nil, err
+	}
+	protected, err := strconv.ParseBool(values[1])
+	if err != nil {
+		return nil, fakeBadRequest("Value of parameter 'value' (%s) must be one of: [true, false]", values[1])
+	}
+	if branch.isMain && !protected {
+		return nil, fakeBadRequest("Main branch can not be unprotected")
+	}
+
+	branch.protected = protected
+	return nil, nil
+}
+
+// Links
+
+func (f *fakeSonarCloud) createLink(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "projectKey")
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "name", "url")
+	if err != nil {
+		return nil, err
+	}
+
+	link := fakeLink{id: strconv.Itoa(f.nextID()), name: values[0], url: values[1]}
+	project.links = append(project.links, link)
+	return fakeObject{"link": fakeObject{"id": link.id, "name": link.name, "url": link.url}}, nil
+}
+
+func (f *fakeSonarCloud) searchLinks(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "projectKey")
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]fakeObject, len(project.links))
+	for i, link := range project.links {
+		links[i] = fakeObject{"id": link.id, "name": link.name, "url": link.url}
+	}
+	return fakeObject{"links": links}, nil
+}
+
+func (f *fakeSonarCloud) deleteLink(params url.Values) (interface{}, error) {
+	values, err := required(params, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range f.projects {
+		for i, link := range project.links {
+			if link.id == values[0] {
+				project.links = append(project.links[:i], project.links[i+1:]...)
+				return nil, nil
+			}
+		}
+	}
+	return nil, fakeNotFound("Link with id '%s' not found", values[0])
+}
+
+// Settings
+
+// settingsScope returns the settings of the project in the component parameter, or of the organization if it is not set
+func (f *fakeSonarCloud) settingsScope(params url.Values) (map[string]fakeSetting, error) {
+	if params.Get("component") == "" {
+		return f.settings, nil
+	}
+	project, err := f.project(params.Get("component"))
+	if err != nil {
+		return nil, err
+	}
+	return project.settings, nil
+}
+
+func (f *fakeSonarCloud) setSetting(params url.Values) (interface{}, error) {
+	settings, err := f.settingsScope(params)
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "key")
+	if err != nil {
+		return nil, err
+	}
+
+	var setting fakeSetting
+	switch {
+	case params.Get("value") != "":
+		setting.value = params.Get("value")
+	case len(params["values"]) > 0:
+		setting.values = params["values"]
+	case len(params["fieldValues"]) > 0:
+		for _, raw := range params["fieldValues"] {
+			fields := map[string]string{}
+			if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+				return nil, fakeBadRequest("JSON '%s' does not respect expected format for setting '%s'", raw, values[0])
+			}
+			setting.fieldValues = append(setting.fieldValues, fields)
+		}
+	default:
+		return nil, fakeBadRequest("Either 'value', 'values' or 'fieldValues' must be provided")
+	}
+
+	settings[values[0]] = setting
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) resetSettings(params url.Values) (interface{}, error) {
+	settings, err := f.settingsScope(params)
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "keys")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range strings.Split(values[0], ",") {
+		delete(settings, key)
+	}
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) settingValues(params url.Values) (interface{}, error) {
+	settings, err := f.settingsScope(params)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0)
+	if params.Get("keys") != "" {
+		keys = strings.Split(params.Get("keys"), ",")
+	} else {
+		for key := range settings {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+	}
+
+	result := make([]fakeObject, 0)
+	for _, key := range keys {
+		setting, ok := settings[key]
+		if !ok {
+			continue
+		}
+		value := fakeObject{"key": key, "inherited": false}
+		switch {
+		case setting.value != "":
+			value["value"] = setting.value
+		case setting.values != nil:
+			value["values"] = setting.values
+		default:
+			value["fieldValues"] = setting.fieldValues
+		}
+		result = append(result, value)
+	}
+	return fakeObject{"settings": result}, nil
+}
+
+// Metrics
+
+func (f *fakeSonarCloud) searchMetrics(params url.Values) (interface{}, error) {
+	page, pager := fakePage(fakeMetrics, params)
+	return fakeObject{"p": pager.PageIndex, "ps": pager.PageSize, "total": pager.Total, "metrics": page}, nil
+}
+
+// Quality gates
+
+func (f *fakeSonarCloud) gate(params url.Values, name string) (*fakeQualityGate, error) {
+	values, err := required(params, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, gate := range f.gates {
+		if strconv.Itoa(gate.id) == values[0] {
+			return gate, nil
+		}
+	}
+	return nil, fakeNotFound("No quality gate has been found for id %s", values[0])
+}
+
+// modifiableGate returns the gate with the ID in the given parameter, if it is not built-in
+func (f *fakeSonarCloud) modifiableGate(params url.Values, name string) (*fakeQualityGate, error) {
+	gate, err := f.gate(params, name)
+	if err != nil {
+		return nil, err
+	}
+	if gate.isBuiltIn {
+		return nil, fakeBadRequest("Operation forbidden for built-in Quality Gate '%s'", gate.name)
+	}
+	return gate, nil
+}
+
+// uniqueGateName returns the name in the name parameter, if no other gate than the given one has it
+func (f *fakeSonarCloud) uniqueGateName(params url.Values, renamed *fakeQualityGate) (string, error) {
+	values, err := required(params, "name")
+	if err != nil {
+		return "", err
+	}
+	for _, gate := range f.gates {
+		if gate.name == values[0] && gate != renamed {
+			return "", fakeBadRequest("Name has already been taken")
+		}
+	}
+	return values[0], nil
+}
+
+// condition returns the condition with the ID in the id parameter, and the gate that it belongs to
+func (f *fakeSonarCloud) condition(params url.Values) (*fakeQualityGate, int, error) {
+	values, err := required(params, "id")
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, gate := range f.gates {
+		for i, condition := range gate.conditions {
+			if strconv.Itoa(condition.id) != values[0] {
+				continue
+			}
+			if gate.isBuiltIn {
+				return nil, 0, fakeBadRequest("Operation forbidden for built-in Quality Gate '%s'", gate.name)
+			}
+			return gate, i, nil
+		}
+	}
+	return nil, 0, fakeNotFound("No quality gate condition with id '%s'", values[0])
+}
+
+func fakeConditionFrom(params url.Values, id int) (fakeCondition, error) {
+	values, err := required(params, "metric", "op", "error")
+	if err != nil {
+		return fakeCondition{}, err
+	}
+	if values[1] != "LT" && values[1] != "GT" {
+		return fakeCondition{}, fakeBadRequest("Value of parameter 'op' (%s) must be one of: [LT, GT]", values[1])
+	}
+	return fakeCondition{id: id, metric: values[0], op: values[1], threshold: values[2]}, nil
+}
+
+func (c fakeCondition) object() fakeObject {
+	return fakeObject{"id": c.id, "metric": c.metric, "op": c.op, "error": c.threshold}
+}
+
+func (f *fakeSonarCloud) listGates(_ url.Values) (interface{}, error) {
+	gates := make([]fakeObject, len(f.gates))
+	for i, gate := range f.gates {
+		conditions := make([]fakeObject, len(gate.conditions))
+		for j, condition := range gate.conditions {
+			conditions[j] = condition.object()
+		}
+		gates[i] = fakeObject{
+			"id":         gate.id,
+			"name":       gate.name,
+			"isBuiltIn":  gate.isBuiltIn,
+			"isDefault":  gate.id == f.defaultGate,
+			"conditions": conditions,
+		}
+	}
+	return fakeObject{"default": f.defaultGate, "qualitygates": gates}, nil
+}
+
+func (f *fakeSonarCloud) createGate(params url.Values) (interface{}, error) {
+	name, err := f.uniqueGateName(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gate := &fakeQualityGate{id: f.nextID(), name: name}
+	f.gates = append(f.gates, gate)
+	return fakeObject{"id": gate.id, "name": gate.name}, nil
+}
+
+func (f *fakeSonarCloud) copyGate(params url.Values) (interface{}, error) {
+	source, err := f.gate(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	name, err := f.uniqueGateName(params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gate := &fakeQualityGate{id: f.nextID(), name: name}
+	for _, condition := range source.conditions {
+		condition.id = f.nextID()
+		gate.conditions = append(gate.conditions, condition)
+	}
+	f.gates = append(f.gates, gate)
+	return fakeObject{"id": gate.id, "name": gate.name}, nil
+}
+
+func (f *fakeSonarCloud) renameGate(params url.Values) (interface{}, error) {
+	gate, err := f.modifiableGate(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	name, err := f.uniqueGateName(params, gate)
+	if err != nil {
+		return nil, err
+	}
+
+	gate.name = name
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) destroyGate(params url.Values) (interface{}, error) {
+	gate, err := f.modifiableGate(params, "id")
+	if err != nil {
+		return nil, err
+	}
+	if gate.id == f.defaultGate {
+		return nil, fakeBadRequest("The default quality gate cannot be removed")
+	}
+
+	for i, g := range f.gates {
+		if g == gate {
+			f.gates = append(f.gates[:i], f.gates[i+1:]...)
+			break
+		}
+	}
+	for _, project := range f.projects {
+		if project.gateID == gate.id {
+			project.gateID = 0
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) setDefaultGate(params url.Values) (interface{}, error) {
+	gate, err := f.gate(params, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	f.defaultGate = gate.id
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) createCondition(params url.Values) (interface{}, error) {
+	gate, err := f.modifiableGate(params, "gateId")
+	if err != nil {
+		return nil, err
+	}
+	condition, err := fakeConditionFrom(params, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range gate.conditions {
+		if c.metric == condition.metric {
+			return nil, fakeBadRequest("Condition on metric '%s' already exists.", condition.metric)
+		}
+	}
+
+	condition.id = f.nextID()
+	gate.conditions = append(gate.conditions, condition)
+	return condition.object(), nil
+}
+
+func (f *fakeSonarCloud) updateCondition(params url.Values) (interface{}, error) {
+	gate, i, err := f.condition(params)
+	if err != nil {
+		return nil, err
+	}
+	condition, err := fakeConditionFrom(params, gate.conditions[i].id)
+	if err != nil {
+		return nil, err
+	}
+
+	gate.conditions[i] = condition
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) deleteCondition(params url.Values) (interface{}, error) {
+	gate, i, err := f.condition(params)
+	if err != nil {
+		return nil, err
+	}
+
+	gate.conditions = append(gate.conditions[:i], gate.conditions[i+1:]...)
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) selectGate(params url.Values) (interface{}, error) {
+	gate, err := f.gate(params, "gateId")
+	if err != nil {
+		return nil, err
+	}
+	project, err := f.projectParam(params, "projectKey")
+	if err != nil {
+		return nil, err
+	}
+
+	project.gateID = gate.id
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) deselectGate(params url.Values) (interface{}, error) {
+	project, err := f.projectParam(params, "projectKey")
+	if err != nil {
+		return nil, err
+	}
+
+	project.gateID = 0
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) searchGateProjects(params url.Values) (interface{}, error) {
+	gate, err := f.gate(params, "gateId")
+	if err != nil {
+		return nil, err
+	}
+	filter := params.Get("selected")
+	if filter == "" {
+		filter = "selected"
+	}
+
+	results := make([]fakeObject, 0)
+	for _, project := range f.sortedProjects() {
+		selected := project.gateID == gate.id
+		if (filter == "selected" && !selected) || (filter == "deselected" && selected) {
+			continue
+		}
+		results = append(results, fakeObject{"id": project.id, "key": project.key, "name": project.name, "selected": selected})
+	}
+	return fakeObject{
+		"paging":  paging.Paging{PageIndex: 1, PageSize: max(len(results), 1), Total: len(results)},
+		"results": results,
+	}, nil
+}
+
+// User groups
+
+func (f *fakeSonarCloud) addGroup(name, description string) *fakeGroup {
+	group := &fakeGroup{id: f.nextID(), name: name, description: description, members: map[string]bool{}}
+	f.groups = append(f.groups, group)
+	return group
+}
+
+// group returns the group with the ID or name in the given parameters
+func (f *fakeSonarCloud) group(params url.Values, idParam, nameParam string) (*fakeGroup, error) {
+	id, name := params.Get(idParam), params.Get(nameParam)
+	if id == "" && name == "" {
+		return nil, fakeBadRequest("Group name or group id must be provided")
+	}
+	for _, group := range f.groups {
+		if (id != "" && strconv.Itoa(group.id) == id) || (id == "" && group.name == name) {
+			return group, nil
+		}
+	}
+	return nil, fakeNotFound("No group with name '%s'", name+id)
+}
+
+func (f *fakeSonarCloud) user(login string) (fakeUser, error) {
+	for _, user := range f.users {
+		if user.login == login {
+			return user, nil
+		}
+	}
+	return fakeUser{}, fakeNotFound("User with login '%s' is not found", login)
+}
+
+func (g *fakeGroup) object() fakeObject {
+	return fakeObject{
+		"id":           g.id,
+		"name":         g.name,
+		"description":  g.description,
+		"membersCount": len(g.members),
+		"default":      g.isDefault,
+	}
+}
+
+func (f *fakeSonarCloud) createGroup(params url.Values) (interface{}, error) {
+	values, err := required(params, "name")
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range f.groups {
+		if strings.EqualFold(group.name, values[0]) {
+			return nil, fakeBadRequest("Group '%s' already exists", values[0])
+		}
+	}
+
+	group := f.addGroup(values[0], params.Get("description"))
+	object := group.object()
+	object["organization"] = fakeOrganization
+	return fakeObject{"group": object}, nil
+}
+
+func (f *fakeSonarCloud) updateGroup(params url.Values) (interface{}, error) {
+	group, err := f.group(params, "id", "")
+	if err != nil {
+		return nil, err
+	}
+	if name := params.Get("name"); name != "" {
+		group.name = name
+	}
+	if _, ok := params["description"]; ok {
+		group.description = params.Get("description")
+	}
+	return fakeObject{"group": group.object()}, nil
+}
+
+func (f *fakeSonarCloud) deleteGroup(params url.Values) (interface{}, error) {
+	group, err := f.group(params, "id", "name")
+	if err != nil {
+		return nil, err
+	}
+	if group.isDefault {
+		return nil, fakeBadRequest("Default group '%s' cannot be used to perform this action", group.name)
+	}
+
+	for i, g := range f.groups {
+		if g == group {
+			f.groups = append(f.groups[:i], f.groups[i+1:]...)
+			break
+		}
+	}
+	delete(f.permissions.groups, group.id)
+	for _, project := range f.projects {
+		delete(project.permissions.groups, group.id)
+	}
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) searchGroups(params url.Values) (interface{}, error) {
+	q := strings.ToLower(params.Get("q"))
+
+	groups := make([]fakeObject, 0)
+	for _, group := range f.groups {
+		if strings.Contains(strings.ToLower(group.name), q) {
+			groups = append(groups, group.object())
+		}
+	}
+
+	page, pager := fakePage(groups, params)
+	return fakeObject{"groups": page, "paging": pager}, nil
+}
+
+func (f *fakeSonarCloud) addGroupMember(params url.Values) (interface{}, error) {
+	group, err := f.group(params, "id", "name")
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "login")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.user(values[0]); err != nil {
+		return nil, err
+	}
+
+	group.members[values[0]] = true
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) removeGroupMember(params url.Values) (interface{}, error) {
+	group, err := f.group(params, "id", "name")
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "login")
+	if err != nil {
+		return nil, err
+	}
+
+	delete(group.members, values[0])
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) groupMembers(params url.Values) (interface{}, error) {
+	group, err := f.group(params, "id", "name")
+	if err != nil {
+		return nil, err
+	}
+	q := strings.ToLower(params.Get("q"))
+	filter := params.Get("selected")
+	if filter == "" {
+		filter = "selected"
+	}
+
+	users := make([]fakeObject, 0)
+	for _, user := range f.users {
+		selected := group.members[user.login]
+		if (filter == "selected" && !selected) || (filter == "deselected" && selected) {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(user.login), q) && !strings.Contains(strings.ToLower(user.name), q) {
+			continue
+		}
+		users = append(users, fakeObject{"login": user.login, "name": user.name, "selected": selected})
+	}
+
+	page, pager := fakePage(users, params)
+	return fakeObject{"p": pager.PageIndex, "ps": pager.PageSize, "total": pager.Total, "users": page}, nil
+}
+
+// Permissions
+
+// permissionsScope returns the permissions of the project in the projectKey parameter, or of the organization if it is not set,
+// and the permissions that can be granted on that scope
+func (f *fakeSonarCloud) permissionsScope(params url.Values) (fakePermissions, []string, error) {
+	if params.Get("projectKey") == "" {
+		return f.permissions, fakeGlobalPermissions, nil
+	}
+	project, err := f.project(params.Get("projectKey"))
+	if err != nil {
+		return fakePermissions{}, nil, err
+	}
+	return project.permissions, fakeProjectPermissions, nil
+}
+
+// permissionParam returns the permissions of the scope and the permission to grant or revoke on it
+func (f *fakeSonarCloud) permissionParam(params url.Values) (fakePermissions, string, error) {
+	permissions, allowed, err := f.permissionsScope(params)
+	if err != nil {
+		return fakePermissions{}, "", err
+	}
+	values, err := required(params, "permission")
+	if err != nil {
+		return fakePermissions{}, "", err
+	}
+	for _, permission := range allowed {
+		if permission == values[0] {
+			return permissions, permission, nil
+		}
+	}
+	return fakePermissions{}, "", fakeBadRequest("Value of parameter 'permission' (%s) must be one of: [%s]", values[0], strings.Join(allowed, ", "))
+}
+
+// permissionGroupID returns the ID of the group in the parameters, which can also be the Anyone group
+func (f *fakeSonarCloud) permissionGroupID(params url.Values) (int, error) {
+	if strings.EqualFold(params.Get("groupName"), fakeAnyoneGroupName) {
+		return fakeAnyoneGroupID, nil
+	}
+	group, err := f.group(params, "groupId", "groupName")
+	if err != nil {
+		return 0, err
+	}
+	return group.id, nil
+}
+
+func (f *fakeSonarCloud) addGroupPermission(params url.Values) (interface{}, error) {
+	permissions, permission, err := f.permissionParam(params)
+	if err != nil {
+		return nil, err
+	}
+	id, err := f.permissionGroupID(params)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions.grantGroup(id, permission)
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) removeGroupPermission(params url.Values) (interface{}, error) {
+	permissions, permission, err := f.permissionParam(params)
+	if err != nil {
+		return nil, err
+	}
+	id, err := f.permissionGroupID(params)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(permissions.groups[id], permission)
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) addUserPermission(params url.Values) (interface{}, error) {
+	permissions, permission, err := f.permissionParam(params)
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "login")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.user(values[0]); err != nil {
+		return nil, err
+	}
+
+	permissions.grantUser(values[0], permission)
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) removeUserPermission(params url.Values) (interface{}, error) {
+	permissions, permission, err := f.permissionParam(params)
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "login")
+	if err != nil {
+		return nil, err
+	}
+
+	delete(permissions.users[values[0]], permission)
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) searchGroupPermissions(params url.Values) (interface{}, error) {
+	permissions, _, err := f.permissionsScope(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like the API, the Anyone group is listed first and has no ID
+	groups := []fakeObject{{
+		"name":        fakeAnyoneGroupName,
+		"permissions": sortedKeys(permissions.groups[fakeAnyoneGroupID]),
+	}}
+	for _, group := range f.groups {
+		groups = append(groups, fakeObject{
+			"id":          strconv.Itoa(group.id),
+			"name":        group.name,
+			"description": group.description,
+			"permissions": sortedKeys(permissions.groups[group.id]),
+		})
+	}
+
+	page, pager := fakePage(groups, params)
+	return fakeObject{"groups": page, "paging": pager}, nil
+}
+
+func (f *fakeSonarCloud) searchUserPermissions(params url.Values) (interface{}, error) {
+	permissions, _, err := f.permissionsScope(params)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]fakeObject, len(f.users))
+	for i, user := range f.users {
+		users[i] = fakeObject{
+			"login":       user.login,
+			"name":        user.name,
+			"avatar":      fmt.Sprintf("%x", md5.Sum([]byte(user.login))), //nolint:gosec // Not used for security
+			"permissions": sortedKeys(permissions.users[user.login]),
+		}
+	}
+
+	page, pager := fakePage(users, params)
+	return fakeObject{"users": page, "paging": pager}, nil
+}
+
+// User tokens
+
+// tokenLogin returns the user in the login parameter, which defaults to the user of the token that authenticates the requests
+func (f *fakeSonarCloud) tokenLogin(params url.Values) (string, error) {
+	login := params.Get("login")
+	if login == "" {
+		login = fakeAdminLogin
+	}
+	if _, err := f.user(login); err != nil {
+		return "", err
+	}
+	return login, nil
+}
+
+func (f *fakeSonarCloud) generateToken(params url.Values) (interface{}, error) {
+	login, err := f.tokenLogin(params)
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "name")
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range f.tokens[login] {
+		if token.name == values[0] {
+			return nil, fakeBadRequest("A user token for login '%s' and name '%s' already exists", login, values[0])
+		}
+	}
+
+	token := fakeUserToken{name: values[0], createdAt: time.Now().UTC().Format("2006-01-02T15:04:05-0700")}
+	f.tokens[login] = append(f.tokens[login], token)
+	return fakeObject{
+		"login":     login,
+		"name":      token.name,
+		"token":     fmt.Sprintf("fake-user-token-%d", f.nextID()),
+		"createdAt": token.createdAt,
+	}, nil
+}
+
+func (f *fakeSonarCloud) revokeToken(params url.Values) (interface{}, error) {
+	login, err := f.tokenLogin(params)
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, token := range f.tokens[login] {
+		if token.name == values[0] {
+			f.tokens[login] = append(f.tokens[login][:i], f.tokens[login][i+1:]...)
+			return nil, nil
+		}
+	}
+	return nil, fakeNotFound("User token with name '%s' doesn't exist for user '%s'", values[0], login)
+}
+
+func (f *fakeSonarCloud) searchTokens(params url.Values) (interface{}, error) {
+	login, err := f.tokenLogin(params)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]fakeObject, len(f.tokens[login]))
+	for i, token := range f.tokens[login] {
+		tokens[i] = fakeObject{"name": token.name, "createdAt": token.createdAt}
+	}
+	return fakeObject{"login": login, "userTokens": tokens}, nil
+}
+
+// Webhooks
+
+func (f *fakeSonarCloud) webhook(params url.Values) (*fakeWebhook, int, error) {
+	values, err := required(params, "webhook")
+	if err != nil {
+		return nil, 0, err
+	}
+	for i, webhook := range f.webhooks {
+		if webhook.key == values[0] {
+			return webhook, i, nil
+		}
+	}
+	return nil, 0, fakeNotFound("No webhook with key '%s'", values[0])
+}
+
+func (w *fakeWebhook) object() fakeObject {
+	return fakeObject{"key": w.key, "name": w.name, "url": w.url, "hasSecret": w.secret != ""}
+}
+
+func (f *fakeSonarCloud) createWebhook(params url.Values) (interface{}, error) {
+	values, err := required(params, "name", "url")
+	if err != nil {
+		return nil, err
+	}
+	if project := params.Get("project"); project != "" {
+		if _, err := f.project(project); err != nil {
+			return nil, err
+		}
+	}
+
+	webhook := &fakeWebhook{
+		key:     fmt.Sprintf("fake-webhook-%d", f.nextID()),
+		name:    values[0],
+		url:     values[1],
+		secret:  params.Get("secret"),
+		project: params.Get("project"),
+	}
+	f.webhooks = append(f.webhooks, webhook)
+	return fakeObject{"webhook": webhook.object()}, nil
+}
+
+func (f *fakeSonarCloud) updateWebhook(params url.Values) (interface{}, error) {
+	webhook, _, err := f.webhook(params)
+	if err != nil {
+		return nil, err
+	}
+	values, err := required(params, "name", "url")
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.name = values[0]
+	webhook.url = values[1]
+	webhook.secret = params.Get("secret")
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) deleteWebhook(params url.Values) (interface{}, error) {
+	_, i, err := f.webhook(params)
+	if err != nil {
+		return nil, err
+	}
+
+	f.webhooks = append(f.webhooks[:i], f.webhooks[i+1:]...)
+	return nil, nil
+}
+
+func (f *fakeSonarCloud) listWebhooks(params url.Values) (interface{}, error) {
+	project := params.Get("project")
+	if project != "" {
+		if _, err := f.project(project); err != nil {
+			return nil, err
+		}
+	}
+
+	webhooks := make([]fakeObject, 0)
+	for _, webhook := range f.webhooks {
+		if webhook.project == project {
+			webhooks = append(webhooks, webhook.object())
+		}
+	}
+	return fakeObject{"webhooks": webhooks}, nil
+}
+
+func TestFakeSonarCloud(t *testing.T) {
+	fake := newFakeSonarCloud()
+	defer fake.Close()
+
+	httpClient, err := newHTTPClient(context.Background(), httpClientConfig{BaseURL: fake.URL})
+	if err != nil {
+		t.Fatalf("could not create HTTP client: %+v", err)
+	}
+	client := sonarcloud.NewClient(fakeOrganization, fakeToken, httpClient)
+
+	t.Run("authentication", func(t *testing.T) {
+		unauthenticated := sonarcloud.NewClient(fakeOrganization, "wrong-token", httpClient)
+		if _, err := unauthenticated.UserGroups.SearchAll(user_groups.SearchRequest{}); err == nil {
+			t.Error("expected an error for an invalid token")
+		}
+	})
+
+	t.Run("projects", func(t *testing.T) {
+		if _, err := client.Projects.Create(projects.CreateRequest{Project: "test", Name: "Test"}); err != nil {
+			t.Fatalf("could not create project: %+v", err)
+		}
+		if _, err := client.Projects.Create(projects.CreateRequest{Project: "test", Name: "Test"}); err == nil {
+			t.Error("expected an error for a duplicate project key")
+		}
+		if err := client.Projects.UpdateKey(projects.UpdateKeyRequest{From: "test", To: "renamed"}); err != nil {
+			t.Fatalf("could not update project key: %+v", err)
+		}
+
+		response, err := client.Projects.SearchAll(projects.SearchRequest{Projects: "renamed"})
+		if err != nil {
+			t.Fatalf("could not search projects: %+v", err)
+		}
+		if len(response.Components) != 1 || response.Components[0].Visibility != "public" {
+			t.Errorf("expected the renamed public project, got %+v", response.Components)
+		}
+
+		branches, excludedFromPurge, err := listProjectBranches(client, "renamed")
+		if err != nil {
+			t.Fatalf("could not list branches: %+v", err)
+		}
+		if len(branches.Branches) != 1 || !branches.Branches[0].IsMain || branches.Branches[0].Name != "main" {
+			t.Errorf("expected a main branch, got %+v", branches.Branches)
+		}
+		if _, ok := excludedFromPurge["main"]; !ok {
+			t.Errorf("expected whether the main branch is excluded from purging, got %+v", excludedFromPurge)
+		}
+	})
+
+	t.Run("quality gates", func(t *testing.T) {
+		gate, err := client.Qualitygates.Create(qualitygates.CreateRequest{Name: "test"})
+		if err != nil {
+			t.Fatalf("could not create quality gate: %+v", err)
+		}
+		gateID := fmt.Sprintf("%d", int(gate.Id))
+		if _, err := client.Qualitygates.CreateCondition(qualitygates.CreateConditionRequest{GateId: gateID, Metric: "coverage", Op: "LT", Error: "80"}); err != nil {
+			t.Fatalf("could not create condition: %+v", err)
+		}
+		if err := client.Qualitygates.Select(qualitygates.SelectRequest{GateId: gateID, ProjectKey: fakeProjectKey}); err != nil {
+			t.Fatalf("could not select quality gate: %+v", err)
+		}
+
+		selection, err := client.Qualitygates.Search(qualitygates.SearchRequest{GateId: gateID})
+		if err != nil {
+			t.Fatalf("could not search quality gate projects: %+v", err)
+		}
+		if len(selection.Results) != 1 || selection.Results[0].Key != fakeProjectKey {
+			t.Errorf("expected the selected project, got %+v", selection.Results)
+		}
+
+		list, err := client.Qualitygates.List(qualitygates.ListRequest{})
+		if err != nil {
+			t.Fatalf("could not list quality gates: %+v", err)
+		}
+		if int(list.Default) != fakeSonarWayGateID || len(list.Qualitygates) != 2 || len(list.Qualitygates[1].Conditions) != 1 {
+			t.Errorf("expected the built-in and created quality gates, got %+v", list)
+		}
+		if err := client.Qualitygates.Destroy(qualitygates.DestroyRequest{Id: strconv.Itoa(fakeSonarWayGateID)}); err == nil {
+			t.Error("expected an error when destroying the built-in quality gate")
+		}
+
+		catalog, err := client.Metrics.SearchAll(metrics.SearchRequest{})
+		if err != nil {
+			t.Fatalf("could not search metrics: %+v", err)
+		}
+		if len(catalog.Metrics) != len(fakeMetrics) {
+			t.Errorf("expected %d metrics, got %d", len(fakeMetrics), len(catalog.Metrics))
+		}
+	})
+
+	t.Run("permissions", func(t *testing.T) {
+		request := permissions.AddGroupRequest{GroupName: fakeTestGroupName, Permission: "scan", ProjectKey: fakeProjectKey}
+		if err := client.Permissions.AddGroup(request); err != nil {
+			t.Fatalf("could not add group permission: %+v", err)
+		}
+		if err := client.Permissions.AddGroup(permissions.AddGroupRequest{GroupName: fakeTestGroupName, Permission: "codeviewer"}); err == nil {
+			t.Error("expected an error for a project permission on the organization")
+		}
+
+		searchRequest := UserGroupPermissionsSearchRequest{ProjectKey: fakeProjectKey}
+		groups, err := sonarcloud.GetAll[UserGroupPermissionsSearchRequest, UserGroupPermissionsSearchResponseGroup](client, "/permissions/groups", searchRequest, "groups")
+		if err != nil {
+			t.Fatalf("could not search group permissions: %+v", err)
+		}
+		group, ok := findUserGroup(groups, fakeTestGroupName)
+		if len(groups) != 4 || !ok || len(group.Permissions) != 1 || group.Permissions[0] != "scan" {
+			t.Errorf("expected the scan permission of the test group, got %+v", groups)
+		}
+	})
+
+	t.Run("user groups", func(t *testing.T) {
+		if err := client.UserGroups.AddUser(user_groups.AddUserRequest{Name: fakeTestGroupName, Login: fakeUserLogin}); err != nil {
+			t.Fatalf("could not add group member: %+v", err)
+		}
+
+		members, err := client.UserGroups.UsersAll(user_groups.UsersRequest{Name: fakeTestGroupName})
+		if err != nil {
+			t.Fatalf("could not list group members: %+v", err)
+		}
+		if len(members.Users) != 1 || members.Users[0].Login != fakeUserLogin {
+			t.Errorf("expected the test user, got %+v", members.Users)
+		}
+	})
+
+	t.Run("settings", func(t *testing.T) {
+		request := SettingSetRequest{Component: fakeProjectKey, Key: "sonar.exclusions", Values: []string{"a", "b"}}
+		if err := sonarcloud.Post(client, "/settings/set", request); err != nil {
+			t.Fatalf("could not set setting: %+v", err)
+		}
+
+		response, err := getWithResponse[SettingValuesResponse](client, "/settings/values", "component", fakeProjectKey, "keys", "sonar.exclusions")
+		if err != nil {
+			t.Fatalf("could not read setting: %+v", err)
+		}
+		if len(response.Settings) != 1 || len(response.Settings[0].Values) != 2 {
+			t.Errorf("expected the setting values, got %+v", response.Settings)
+		}
+	})
+
+	t.Run("webhooks and tokens", func(t *testing.T) {
+		if _, err := client.Webhooks.Create(webhooks.CreateRequest{Name: "test", Url: "https://example.com", Secret: "secret"}); err != nil {
+			t.Fatalf("could not create webhook: %+v", err)
+		}
+		list, err := client.Webhooks.List(webhooks.ListRequest{})
+		if err != nil {
+			t.Fatalf("could not list webhooks: %+v", err)
+		}
+		if len(list.Webhooks) != 1 || !list.Webhooks[0].HasSecret {
+			t.Errorf("expected the organization webhook with a secret, got %+v", list.Webhooks)
+		}
+
+		token, err := client.UserTokens.Generate(user_tokens.GenerateRequest{Login: fakeAdminLogin, Name: "test"})
+		if err != nil {
+			t.Fatalf("could not generate token: %+v", err)
+		}
+		if token.Token == "" {
+			t.Error("expected a generated token")
+		}
+	})
+}
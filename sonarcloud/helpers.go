@@ -1,9 +1,13 @@
 package sonarcloud
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/cenkalti/backoff/v4"
 	"math/big"
+	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/project_branches"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/qualitygates"
@@ -19,9 +24,9 @@ import (
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/user_tokens"
 )
 
-// changedAttrs returns a map where the keys are the names of all the attributes that were changed
-// Note that the name is not the full path, but only the AttributeName of the last path step.
-func changedAttrs(req tfsdk.UpdateResourceRequest, diags diag.Diagnostics) map[string]struct{} {
+// changedAttrs returns a map where the keys are the names of all the top-level attributes that were changed.
+// Changes to the elements of lists, sets and maps, or to nested attributes, are reported on the attribute that holds them.
+func changedAttrs(req tfsdk.UpdateResourceRequest, diags *diag.Diagnostics) map[string]struct{} {
 	diffs, err := req.Plan.Raw.Diff(req.State.Raw)
 	if err != nil {
 		diags.AddError(
@@ -32,11 +37,16 @@ func changedAttrs(req tfsdk.UpdateResourceRequest, diags diag.Diagnostics) map[s
 
 	changes := make(map[string]struct{})
 	for _, diff := range diffs {
-		steps := diff.Path.Steps()
-		index := len(steps) - 1
+		// Elements that were added or removed only have a value on one side of the diff
+		if diff.Value1 != nil && diff.Value2 != nil && diff.Value1.Equal(*diff.Value2) {
+			continue
+		}
 
-		if !diff.Value1.Equal(*diff.Value2) {
-			attr := steps[index].(tftypes.AttributeName)
+		steps := diff.Path.Steps()
+		if len(steps) == 0 {
+			continue
+		}
+		if attr, ok := steps[0].(tftypes.AttributeName); ok {
 			changes[string(attr)] = struct{}{}
 		}
 	}
@@ -101,6 +111,14 @@ func findProject(response *projects.SearchResponseAll, key string) (Project, boo
 				Name:       types.String{Value: p.Name},
 				Key:        types.String{Value: p.Key},
 				Visibility: types.String{Value: p.Visibility},
+				Tags:       types.Set{ElemType: types.StringType, Null: true},
+
+				SourceExclusions:      types.Set{ElemType: types.StringType, Null: true},
+				SourceInclusions:      types.Set{ElemType: types.StringType, Null: true},
+				TestExclusions:        types.Set{ElemType: types.StringType, Null: true},
+				TestInclusions:        types.Set{ElemType: types.StringType, Null: true},
+				CoverageExclusions:    types.Set{ElemType: types.StringType, Null: true},
+				DuplicationExclusions: types.Set{ElemType: types.StringType, Null: true},
 			}
 			ok = true
 			break
@@ -127,12 +145,13 @@ func findProjectMainBranch(response *project_branches.ListResponse, name, projec
 	return result, ok
 }
 
-// findQualityGate returns the quality gate with the given name if it exists in a response
-func findQualityGate(response *qualitygates.ListResponse, name string) (QualityGate, bool) {
+// findQualityGate returns the quality gate with the given ID if it exists in a response.
+// If the ID is not known (e.g. during import), the gate is matched on its name instead.
+func findQualityGate(response *qualitygates.ListResponse, gateID float64, name string) (QualityGate, bool) {
 	var result QualityGate
 	ok := false
 	for _, q := range response.Qualitygates {
-		if q.Name == name {
+		if q.Id == gateID || (gateID == 0 && q.Name == name) {
 			result = QualityGate{
 				ID:        types.String{Value: fmt.Sprintf("%d", int(q.Id))},
 				GateId:    types.Float64{Value: q.Id},
@@ -155,6 +174,55 @@ func findQualityGate(response *qualitygates.ListResponse, name string) (QualityG
 	return result, ok
 }
 
+// findQualityGateSource returns the quality gate to copy from, which is referenced by either its ID or its name
+func findQualityGateSource(response *qualitygates.ListResponse, idOrName string) (QualityGate, bool) {
+	if id, err := strconv.ParseFloat(idOrName, 64); err == nil {
+		if result, ok := findQualityGate(response, id, ""); ok {
+			return result, true
+		}
+	}
+	return findQualityGate(response, 0, idOrName)
+}
+
+// findQualityProfile returns the quality profile with the given key if it exists in a response.
+// If the key is empty (e.g. during import), the profile is matched on its name instead.
+func findQualityProfile(response *QualityProfilesSearchResponse, key, name string) (QualityProfile, bool) {
+	var result QualityProfile
+	ok := false
+	for _, p := range response.Profiles {
+		if p.Key == key || (key == "" && p.Name == name) {
+			result = QualityProfile{
+				ID:        types.String{Value: p.Key},
+				Key:       types.String{Value: p.Key},
+				Name:      types.String{Value: p.Name},
+				Language:  types.String{Value: p.Language},
+				IsBuiltIn: types.Bool{Value: p.IsBuiltIn},
+				IsDefault: types.Bool{Value: p.IsDefault},
+			}
+			ok = true
+			break
+		}
+	}
+	return result, ok
+}
+
+// findBuiltInQualityProfile returns the built-in quality profile if it exists in a response.
+// "Sonar way" is preferred when a language has multiple built-in profiles.
+func findBuiltInQualityProfile(response *QualityProfilesSearchResponse) (QualityProfile, bool) {
+	var result QualityProfile
+	ok := false
+	for _, p := range response.Profiles {
+		if !p.IsBuiltIn {
+			continue
+		}
+		result, ok = findQualityProfile(response, p.Key, p.Name)
+		if p.Name == "Sonar way" {
+			break
+		}
+	}
+	return result, ok
+}
+
 // findSelection returns a Selection{} struct with the given project keys if they exist in a response
 // this can be sped up using hashmaps, but I didn't feel like introducing a new dependency/taking code from somewhere.
 // Ex library: https://pkg.go.dev/github.com/juliangruber/go-intersect/v2
@@ -179,6 +247,31 @@ func findSelection(response *qualitygates.SearchResponse, keys []attr.Value) (Se
 	}, ok
 }
 
+// selectedProjectKeys returns the keys of all projects that are selected in the response
+func selectedProjectKeys(response *qualitygates.SearchResponse) []attr.Value {
+	projectKeys := make([]attr.Value, 0, len(response.Results))
+	for _, s := range response.Results {
+		if s.Selected {
+			projectKeys = append(projectKeys, types.String{Value: s.Key})
+		}
+	}
+	return projectKeys
+}
+
+// findQualityProfileSelection returns the subset of the given project keys that are selected in the response
+func findQualityProfileSelection(projects []QualityProfileProjectsResponseProject, keys []attr.Value) types.Set {
+	projectKeys := make([]attr.Value, 0, len(keys))
+	for _, k := range keys {
+		for _, p := range projects {
+			if p.Selected && k.Equal(types.String{Value: p.Key}) {
+				projectKeys = append(projectKeys, types.String{Value: p.Key})
+				break
+			}
+		}
+	}
+	return types.Set{ElemType: types.StringType, Elems: projectKeys}
+}
+
 // terraformListString returns the list of items in terraform list notation
 func terraformListString(items []string) string {
 	return fmt.Sprintf(`["%s"]`, strings.Join(items, `","`))
@@ -203,6 +296,24 @@ func stringAttributesContain(haystack []attr.Value, needle string) bool {
 	return false
 }
 
+// stringAttributes returns the given strings as a list of string attributes
+func stringAttributes(items []string) []attr.Value {
+	result := make([]attr.Value, len(items))
+	for i, item := range items {
+		result[i] = types.String{Value: item}
+	}
+	return result
+}
+
+// stringMapAttributes returns the given map as a map of string attributes
+func stringMapAttributes(items map[string]string) map[string]attr.Value {
+	result := make(map[string]attr.Value, len(items))
+	for k, v := range items {
+		result[k] = types.String{Value: v}
+	}
+	return result
+}
+
 // diffAttrSets returns the additions and deletions needed to get from the set we have, to the set we want
 func diffAttrSets(haves, wants types.Set) (toAdd, toRemove []attr.Value) {
 	for _, have := range haves.Elems {
@@ -218,3 +329,75 @@ func diffAttrSets(haves, wants types.Set) (toAdd, toRemove []attr.Value) {
 
 	return toAdd, toRemove
 }
+
+// qualityProfileRulesContain checks if the exact rule activation is found in the list of activations
+func qualityProfileRulesContain(haystack []QualityProfileRule, needle QualityProfileRule) bool {
+	for _, r := range haystack {
+		if r.Rule.Equal(needle.Rule) && r.Severity.Equal(needle.Severity) && r.Params.Equal(needle.Params) {
+			return true
+		}
+	}
+	return false
+}
+
+// qualityProfileRulesContainKey checks if an activation of the rule with the given key is found in the list of activations
+func qualityProfileRulesContainKey(haystack []QualityProfileRule, ruleKey string) bool {
+	for _, r := range haystack {
+		if r.Rule.Value == ruleKey {
+			return true
+		}
+	}
+	return false
+}
+
+// diffQualityProfileRules returns the activations and deactivations needed to get from the rules we have, to the rules we want.
+// Activating a rule that is already active updates its severity and parameters, so changed activations are only activated again.
+func diffQualityProfileRules(haves, wants []QualityProfileRule) (toActivate, toDeactivate []QualityProfileRule) {
+	for _, have := range haves {
+		if !qualityProfileRulesContainKey(wants, have.Rule.Value) {
+			toDeactivate = append(toDeactivate, have)
+		}
+	}
+	for _, want := range wants {
+		if !qualityProfileRulesContain(haves, want) {
+			toActivate = append(toActivate, want)
+		}
+	}
+
+	return toActivate, toDeactivate
+}
+
+// getWithResponse sends a GET request to an endpoint that is not covered by the client and returns the unmarshalled JSON response.
+// Unlike sonarcloud.Get, it does not expect the response to be paged.
+func getWithResponse[R any](client *sonarcloud.Client, path string, params ...string) (*R, error) {
+	req, err := client.GetRequest(sonarcloud.API+path, params...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %+v", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error trying to execute request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		errorResponse, err := sonarcloud.ErrorResponseFrom(resp)
+		if err != nil {
+			return nil, fmt.Errorf("received non 2xx status code (%d), but could not decode error response: %+v", resp.StatusCode, err)
+		}
+		return nil, errorResponse
+	}
+
+	response := new(R)
+	if err = json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("could not decode response: %+v", err)
+	}
+	return response, nil
+}
+
+// isNotFound checks if the error is an error response of the API with the status code 404
+func isNotFound(err error) bool {
+	var errorResponse *sonarcloud.ErrorResponse
+	return errors.As(err, &errorResponse) && errorResponse.StatusCode == http.StatusNotFound
+}
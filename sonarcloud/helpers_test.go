@@ -0,0 +1,359 @@
+package sonarcloud
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/qualitygates"
+)
+
+var testRuleType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"key":      tftypes.String,
+	"severity": tftypes.String,
+}}
+
+var testResourceType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"name":   tftypes.String,
+	"tags":   tftypes.Set{ElementType: tftypes.String},
+	"rules":  tftypes.List{ElementType: testRuleType},
+	"labels": tftypes.Map{ElementType: tftypes.String},
+}}
+
+type testResource struct {
+	name   string
+	tags   []string
+	rules  map[string]string
+	labels map[string]string
+}
+
+// value returns the resource as a Terraform value, with the rules sorted by key
+func (r testResource) value() tftypes.Value {
+	tags := make([]tftypes.Value, 0, len(r.tags))
+	for _, tag := range r.tags {
+		tags = append(tags, tftypes.NewValue(tftypes.String, tag))
+	}
+
+	rules := make([]tftypes.Value, 0, len(r.rules))
+	for _, key := range sortedStrings(r.rules) {
+		rules = append(rules, tftypes.NewValue(testRuleType, map[string]tftypes.Value{
+			"key":      tftypes.NewValue(tftypes.String, key),
+			"severity": tftypes.NewValue(tftypes.String, r.rules[key]),
+		}))
+	}
+
+	labels := make(map[string]tftypes.Value, len(r.labels))
+	for k, v := range r.labels {
+		labels[k] = tftypes.NewValue(tftypes.String, v)
+	}
+
+	return tftypes.NewValue(testResourceType, map[string]tftypes.Value{
+		"name":   tftypes.NewValue(tftypes.String, r.name),
+		"tags":   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tags),
+		"rules":  tftypes.NewValue(tftypes.List{ElementType: testRuleType}, rules),
+		"labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, labels),
+	})
+}
+
+func sortedStrings(items map[string]string) []string {
+	keys := make([]string, 0, len(items))
+	for k := range items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func testUpdateRequest(state, plan testResource) tfsdk.UpdateResourceRequest {
+	return tfsdk.UpdateResourceRequest{
+		State: tfsdk.State{Raw: state.value()},
+		Plan:  tfsdk.Plan{Raw: plan.value()},
+	}
+}
+
+func changedNames(changes map[string]struct{}) []string {
+	names := make([]string, 0, len(changes))
+	for name := range changes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestChangedAttrs(t *testing.T) {
+	state := testResource{
+		name:   "project",
+		tags:   []string{"a", "b"},
+		rules:  map[string]string{"go:S100": "MAJOR", "go:S101": "MINOR"},
+		labels: map[string]string{"team": "a"},
+	}
+
+	tests := map[string]struct {
+		plan     testResource
+		expected []string
+	}{
+		"no changes": {
+			plan:     state,
+			expected: []string{},
+		},
+		"changed attribute": {
+			plan:     testResource{name: "renamed", tags: state.tags, rules: state.rules, labels: state.labels},
+			expected: []string{"name"},
+		},
+		"added set element": {
+			plan:     testResource{name: state.name, tags: []string{"a", "b", "c"}, rules: state.rules, labels: state.labels},
+			expected: []string{"tags"},
+		},
+		"removed set element": {
+			plan:     testResource{name: state.name, tags: []string{"a"}, rules: state.rules, labels: state.labels},
+			expected: []string{"tags"},
+		},
+		"changed nested attribute in list": {
+			plan:     testResource{name: state.name, tags: state.tags, rules: map[string]string{"go:S100": "MAJOR", "go:S101": "BLOCKER"}, labels: state.labels},
+			expected: []string{"rules"},
+		},
+		"removed list element": {
+			plan:     testResource{name: state.name, tags: state.tags, rules: map[string]string{"go:S100": "MAJOR"}, labels: state.labels},
+			expected: []string{"rules"},
+		},
+		"changed map element": {
+			plan:     testResource{name: state.name, tags: state.tags, rules: state.rules, labels: map[string]string{"team": "b"}},
+			expected: []string{"labels"},
+		},
+		"added map element": {
+			plan:     testResource{name: state.name, tags: state.tags, rules: state.rules, labels: map[string]string{"team": "a", "tier": "1"}},
+			expected: []string{"labels"},
+		},
+		"changed everything": {
+			plan:     testResource{name: "renamed", tags: []string{}, rules: map[string]string{}, labels: map[string]string{}},
+			expected: []string{"labels", "name", "rules", "tags"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			changes := changedAttrs(testUpdateRequest(state, test.plan), &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %+v", diags)
+			}
+			if got := changedNames(changes); strings.Join(got, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("expected changes %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestChangedAttrsTypeMismatch(t *testing.T) {
+	req := tfsdk.UpdateResourceRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.String, "state")},
+		Plan:  tfsdk.Plan{Raw: testResource{name: "plan"}.value()},
+	}
+
+	var diags diag.Diagnostics
+	changedAttrs(req, &diags)
+	if !diags.HasError() {
+		t.Error("expected an error when the plan and state have different types")
+	}
+}
+
+func FuzzChangedAttrs(f *testing.F) {
+	f.Add("project", "project", "a", "a", "MAJOR", "MAJOR")
+	f.Add("project", "renamed", "a", "b", "MAJOR", "MINOR")
+	f.Add("", "project", "", "a", "", "INFO")
+
+	f.Fuzz(func(t *testing.T, stateName, planName, stateTag, planTag, stateSeverity, planSeverity string) {
+		state := testResource{name: stateName, tags: []string{stateTag}, rules: map[string]string{"go:S100": stateSeverity}}
+		plan := testResource{name: planName, tags: []string{planTag}, rules: map[string]string{"go:S100": planSeverity}}
+
+		var diags diag.Diagnostics
+		changes := changedAttrs(testUpdateRequest(state, plan), &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %+v", diags)
+		}
+
+		expected := map[string]bool{
+			"name":   stateName != planName,
+			"tags":   stateTag != planTag,
+			"rules":  stateSeverity != planSeverity,
+			"labels": false,
+		}
+		for name, changed := range expected {
+			if _, ok := changes[name]; ok != changed {
+				t.Errorf("expected %s changed to be %t, got changes %v", name, changed, changedNames(changes))
+			}
+		}
+	})
+}
+
+func testSelectionResponse(t *testing.T, results string) *qualitygates.SearchResponse {
+	t.Helper()
+	var response qualitygates.SearchResponse
+	if err := json.Unmarshal([]byte(`{"results":`+results+`}`), &response); err != nil {
+		t.Fatalf("could not unmarshal response: %+v", err)
+	}
+	return &response
+}
+
+func TestFindSelection(t *testing.T) {
+	response := testSelectionResponse(t, `[
+		{"key": "project-a", "selected": true},
+		{"key": "project-b", "selected": true},
+		{"key": "project-c", "selected": false}
+	]`)
+
+	tests := map[string]struct {
+		keys     []string
+		expected []string
+		ok       bool
+	}{
+		"no keys":       {keys: []string{}, expected: []string{}, ok: true},
+		"single key":    {keys: []string{"project-a"}, expected: []string{"project-a"}, ok: true},
+		"multiple keys": {keys: []string{"project-b", "project-a"}, expected: []string{"project-b", "project-a"}, ok: true},
+		"missing key":   {keys: []string{"project-a", "project-d"}, expected: []string{"project-a"}, ok: false},
+		"empty key":     {keys: []string{""}, expected: []string{}, ok: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			selection, ok := findSelection(response, stringAttributes(test.keys))
+			if ok != test.ok {
+				t.Errorf("expected ok to be %t, got %t", test.ok, ok)
+			}
+			expected := types.Set{ElemType: types.StringType, Elems: stringAttributes(test.expected)}
+			if !selection.ProjectKeys.Equal(expected) {
+				t.Errorf("expected project keys %v, got %v", expected, selection.ProjectKeys)
+			}
+		})
+	}
+}
+
+func FuzzFindSelection(f *testing.F) {
+	f.Add("project-a,project-b", "project-a")
+	f.Add("project-a", "project-a,project-b")
+	f.Add("", "")
+
+	f.Fuzz(func(t *testing.T, results, keys string) {
+		response := &qualitygates.SearchResponse{}
+		found := map[string]bool{}
+		trimmed := map[string]bool{}
+		for _, key := range strings.Split(results, ",") {
+			response.Results = append(response.Results, struct {
+				Id       float64 `json:"id,omitempty"`
+				Key      string  `json:"key,omitempty"`
+				Name     string  `json:"name,omitempty"`
+				Selected bool    `json:"selected,omitempty"`
+			}{Key: key, Selected: true})
+			found[key] = true
+			trimmed[strings.Trim(key, `"`)] = true
+		}
+
+		wanted := strings.Split(keys, ",")
+		selection, ok := findSelection(response, stringAttributes(wanted))
+
+		allFound := true
+		for _, key := range wanted {
+			allFound = allFound && found[key]
+		}
+		if ok != allFound {
+			t.Errorf("expected ok to be %t, got %t", allFound, ok)
+		}
+		for _, elem := range selection.ProjectKeys.Elems {
+			if !trimmed[elem.(types.String).Value] {
+				t.Errorf("selection contains %v, which is not in the response", elem)
+			}
+		}
+	})
+}
+
+func testStringSet(items ...string) types.Set {
+	return types.Set{ElemType: types.StringType, Elems: stringAttributes(items)}
+}
+
+func attrStrings(values []attr.Value) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.(types.String).Value)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestDiffAttrSets(t *testing.T) {
+	tests := map[string]struct {
+		haves    types.Set
+		wants    types.Set
+		toAdd    []string
+		toRemove []string
+	}{
+		"both empty":     {haves: testStringSet(), wants: testStringSet(), toAdd: []string{}, toRemove: []string{}},
+		"equal":          {haves: testStringSet("a", "b"), wants: testStringSet("b", "a"), toAdd: []string{}, toRemove: []string{}},
+		"add to empty":   {haves: testStringSet(), wants: testStringSet("a", "b"), toAdd: []string{"a", "b"}, toRemove: []string{}},
+		"remove all":     {haves: testStringSet("a", "b"), wants: testStringSet(), toAdd: []string{}, toRemove: []string{"a", "b"}},
+		"add and remove": {haves: testStringSet("a", "b"), wants: testStringSet("b", "c"), toAdd: []string{"c"}, toRemove: []string{"a"}},
+		"null haves":     {haves: types.Set{ElemType: types.StringType, Null: true}, wants: testStringSet("a"), toAdd: []string{"a"}, toRemove: []string{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			toAdd, toRemove := diffAttrSets(test.haves, test.wants)
+			if got := attrStrings(toAdd); strings.Join(got, ",") != strings.Join(test.toAdd, ",") {
+				t.Errorf("expected to add %v, got %v", test.toAdd, got)
+			}
+			if got := attrStrings(toRemove); strings.Join(got, ",") != strings.Join(test.toRemove, ",") {
+				t.Errorf("expected to remove %v, got %v", test.toRemove, got)
+			}
+		})
+	}
+}
+
+func FuzzDiffAttrSets(f *testing.F) {
+	f.Add("a,b", "b,c")
+	f.Add("", "a")
+	f.Add("a,a,b", "")
+
+	f.Fuzz(func(t *testing.T, haves, wants string) {
+		have := map[string]bool{}
+		for _, h := range strings.Split(haves, ",") {
+			have[h] = true
+		}
+		want := map[string]bool{}
+		for _, w := range strings.Split(wants, ",") {
+			want[w] = true
+		}
+
+		toAdd, toRemove := diffAttrSets(testStringSet(strings.Split(haves, ",")...), testStringSet(strings.Split(wants, ",")...))
+
+		// Applying the diff to the set we have must result in the set we want
+		result := map[string]bool{}
+		for h := range have {
+			result[h] = true
+		}
+		for _, r := range attrStrings(toRemove) {
+			if !have[r] {
+				t.Errorf("removes %q, which is not in the set", r)
+			}
+			delete(result, r)
+		}
+		for _, a := range attrStrings(toAdd) {
+			if have[a] {
+				t.Errorf("adds %q, which is already in the set", a)
+			}
+			result[a] = true
+		}
+		if len(result) != len(want) {
+			t.Fatalf("expected %v after applying the diff, got %v", want, result)
+		}
+		for w := range want {
+			if !result[w] {
+				t.Errorf("expected %v after applying the diff, got %v", want, result)
+			}
+		}
+	})
+}
@@ -0,0 +1,271 @@
+package sonarcloud
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+// defaultBaseURL is the URL of SonarCloud, the API of which is used by the client.
+const defaultBaseURL = "https://sonarcloud.io"
+
+// defaultHTTPTimeout is the time limit for a request to the API including its retries, if it is not configured.
+const defaultHTTPTimeout = 60 * time.Second
+
+// defaultMaxRetries is the number of times a request is retried, if it is not configured.
+const defaultMaxRetries = 3
+
+// defaultRetryMaxWait is the maximum time to wait before retrying a request, if it is not configured.
+const defaultRetryMaxWait = 30 * time.Second
+
+// httpClientConfig holds the settings of the HTTP client that is passed to the SonarCloud client.
+type httpClientConfig struct {
+	BaseURL      string
+	Timeout      time.Duration
+	ProxyURL     string
+	CABundle     string
+	MaxRetries   int
+	RetryMaxWait time.Duration
+}
+
+// newHTTPClient returns an HTTP client with the given settings for the SonarCloud client.
+// The context is only used for logging, because the SonarCloud client does not pass a context with its requests.
+func newHTTPClient(ctx context.Context, config httpClientConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := parseHTTPURL(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if config.CABundle != "" {
+		pool, err := certPoolWith(config.CABundle)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if config.BaseURL != "" && strings.TrimSuffix(config.BaseURL, "/") != defaultBaseURL {
+		baseURL, err := parseHTTPURL(config.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base URL: %w", err)
+		}
+		roundTripper = &baseURLTransport{
+			api:  strings.TrimSuffix(baseURL.String(), "/") + "/api",
+			next: transport,
+		}
+	}
+
+	roundTripper = &loggingTransport{
+		ctx:   ctx,
+		trace: traceEnabled(),
+		next:  roundTripper,
+	}
+
+	if config.MaxRetries > 0 {
+		roundTripper = &retryTransport{
+			ctx:        ctx,
+			maxRetries: config.MaxRetries,
+			maxWait:    config.RetryMaxWait,
+			next:       roundTripper,
+		}
+	}
+
+	return &http.Client{
+		Transport: roundTripper,
+		Timeout:   config.Timeout,
+	}, nil
+}
+
+// parseHTTPURL parses an absolute http or https URL
+func parseHTTPURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("expected an absolute http or https URL, got %q", rawURL)
+	}
+	return u, nil
+}
+
+// certPoolWith returns the system certificate pool with the PEM encoded certificates of the given file added to it
+func certPoolWith(caBundle string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CA bundle: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM encoded certificates found in CA bundle %q", caBundle)
+	}
+	return pool, nil
+}
+
+// baseURLTransport sends requests to another API than SonarCloud.
+// The SonarCloud client always builds its request URLs from sonarcloud.API, so they are rewritten here.
+type baseURLTransport struct {
+	api  string
+	next http.RoundTripper
+}
+
+func (t *baseURLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rawURL := req.URL.String()
+	if !strings.HasPrefix(rawURL, sonarcloud.API) {
+		return t.next.RoundTrip(req)
+	}
+
+	u, err := url.Parse(t.api + strings.TrimPrefix(rawURL, sonarcloud.API))
+	if err != nil {
+		return nil, fmt.Errorf("could not rewrite request URL: %w", err)
+	}
+
+	// A RoundTripper must not modify the request, so a copy is sent instead
+	rewritten := req.Clone(req.Context())
+	rewritten.URL = u
+	rewritten.Host = ""
+	return t.next.RoundTrip(rewritten)
+}
+
+// retryTransport retries requests when the API is rate limited or temporarily unavailable.
+// Reads are also retried after other server and connection errors, but writes are only retried when the API did not process them.
+type retryTransport struct {
+	ctx        context.Context
+	maxRetries int
+	maxWait    time.Duration
+	next       http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoffConfig := backoff.NewExponentialBackOff()
+	backoffConfig.InitialInterval = 250 * time.Millisecond
+	backoffConfig.MaxInterval = t.maxWait
+	backoffConfig.MaxElapsedTime = 0
+
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt > t.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := backoffConfig.NextBackOff()
+		if retryAfter, ok := retryAfterFrom(resp); ok {
+			wait = retryAfter
+		}
+		if wait > t.maxWait {
+			wait = t.maxWait
+		}
+
+		fields := map[string]interface{}{
+			"method":   req.Method,
+			"endpoint": req.URL.Path,
+			"attempt":  attempt,
+			"wait":     wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status_code"] = resp.StatusCode
+			// The body must be read and closed to reuse the connection
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Warn(t.ctx, "Retrying request to the SonarCloud API", fields)
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		attemptReq, err = rewound(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rewound returns a copy of the request with a fresh copy of its body, so it can be sent again
+func rewound(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("could not copy request body: %w", err)
+	}
+	attemptReq := req.Clone(req.Context())
+	attemptReq.Body = body
+	return attemptReq, nil
+}
+
+// shouldRetry checks if the request can be retried after the given response or error
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// A body that cannot be copied can only be sent once
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead
+	if err != nil {
+		return idempotent && req.Context().Err() == nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	default:
+		return false
+	}
+}
+
+// retryAfterFrom returns the time to wait from the Retry-After header of the response, if it has one
+func retryAfterFrom(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
@@ -0,0 +1,135 @@
+package sonarcloud
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+func TestHTTPClientBaseURL(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"branches":[]}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := newHTTPClient(context.Background(), httpClientConfig{BaseURL: server.URL + "/"})
+	if err != nil {
+		t.Fatalf("could not create HTTP client: %+v", err)
+	}
+	client := sonarcloud.NewClient("org", "token", httpClient)
+
+	if _, _, err := listProjectBranches(client, "project"); err != nil {
+		t.Fatalf("request failed: %+v", err)
+	}
+	if expected := "/api/project_branches/list"; requested != expected {
+		t.Errorf("expected request to %s, got %s", expected, requested)
+	}
+}
+
+func TestHTTPClientInvalidConfig(t *testing.T) {
+	tests := map[string]httpClientConfig{
+		"relative base URL":  {BaseURL: "sonarcloud.io"},
+		"unsupported scheme": {BaseURL: "ftp://sonarcloud.io"},
+		"invalid proxy URL":  {ProxyURL: "://proxy"},
+		"missing CA bundle":  {CABundle: "does-not-exist.pem"},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newHTTPClient(context.Background(), config); err == nil {
+				t.Errorf("expected an error for %+v", config)
+			}
+		})
+	}
+}
+
+func TestHTTPClientRetry(t *testing.T) {
+	tests := map[string]struct {
+		method   string
+		status   int
+		expected int
+	}{
+		"read rate limited":      {method: http.MethodGet, status: http.StatusTooManyRequests, expected: 3},
+		"read server error":      {method: http.MethodGet, status: http.StatusBadGateway, expected: 3},
+		"write rate limited":     {method: http.MethodPost, status: http.StatusTooManyRequests, expected: 3},
+		"write server error":     {method: http.MethodPost, status: http.StatusInternalServerError, expected: 1},
+		"read client error":      {method: http.MethodGet, status: http.StatusBadRequest, expected: 1},
+		"write unavailable":      {method: http.MethodPost, status: http.StatusServiceUnavailable, expected: 3},
+		"read gateway timed out": {method: http.MethodGet, status: http.StatusGatewayTimeout, expected: 3},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPost && string(body) != "name=test" {
+					t.Errorf("expected the body to be sent again, got %q", body)
+				}
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			httpClient, err := newHTTPClient(context.Background(), httpClientConfig{MaxRetries: 2, RetryMaxWait: time.Millisecond})
+			if err != nil {
+				t.Fatalf("could not create HTTP client: %+v", err)
+			}
+
+			req, err := http.NewRequest(test.method, server.URL, strings.NewReader("name=test"))
+			if err != nil {
+				t.Fatalf("could not create request: %+v", err)
+			}
+			resp, err := httpClient.Do(req)
+			if err != nil {
+				t.Fatalf("request failed: %+v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != test.status {
+				t.Errorf("expected status %d, got %d", test.status, resp.StatusCode)
+			}
+			if requests != test.expected {
+				t.Errorf("expected %d requests, got %d", test.expected, requests)
+			}
+		})
+	}
+}
+
+func TestRetryAfterFrom(t *testing.T) {
+	tests := map[string]struct {
+		header   string
+		expected time.Duration
+		ok       bool
+	}{
+		"missing":    {header: "", ok: false},
+		"seconds":    {header: "120", expected: 2 * time.Minute, ok: true},
+		"past date":  {header: "Wed, 21 Oct 2015 07:28:00 GMT", expected: 0, ok: true},
+		"negative":   {header: "-1", ok: false},
+		"unparsable": {header: "soon", ok: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.header != "" {
+				resp.Header.Set("Retry-After", test.header)
+			}
+
+			wait, ok := retryAfterFrom(resp)
+			if ok != test.ok || wait != test.expected {
+				t.Errorf("expected (%s, %t), got (%s, %t)", test.expected, test.ok, wait, ok)
+			}
+		})
+	}
+}
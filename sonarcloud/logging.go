@@ -0,0 +1,221 @@
+package sonarcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxTracedBodySize is the maximum number of bytes of a request or response body that is logged in trace mode
+const maxTracedBodySize = 64 * 1024
+
+// redacted replaces the values of sensitive fields in logged request and response bodies
+const redacted = "REDACTED"
+
+// sensitiveFields are the names of request parameters and response fields with values that must not be logged
+var sensitiveFields = map[string]bool{
+	"password": true,
+	"secret":   true,
+	"token":    true,
+}
+
+// attributeGetter is implemented by tfsdk.Config, tfsdk.Plan and tfsdk.State
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// logResourceOperation logs the start of an operation on a resource, and returns a function that logs its end.
+// It is meant to be deferred at the start of the operation:
+//
+//	defer logResourceOperation(ctx, r.p, "sonarcloud_project", "create", req.Plan)(&resp.Diagnostics)
+func logResourceOperation(ctx context.Context, p provider, typeName, operation string, data attributeGetter) func(*diag.Diagnostics) {
+	return logOperation(ctx, p, typeName, data, map[string]interface{}{
+		"resource_type": typeName,
+		"operation":     operation,
+	})
+}
+
+// logDataSourceOperation logs the start of reading a data source, and returns a function that logs its end.
+// It is meant to be deferred at the start of the read, like logResourceOperation.
+func logDataSourceOperation(ctx context.Context, p provider, typeName string, data attributeGetter) func(*diag.Diagnostics) {
+	return logOperation(ctx, p, typeName, data, map[string]interface{}{
+		"data_source_type": typeName,
+		"operation":        "read",
+	})
+}
+
+// projectKeyAttributes are the attributes that hold the key of the project, for the types that do not name it project_key
+var projectKeyAttributes = map[string]string{
+	"sonarcloud_project":          "key",
+	"sonarcloud_imported_project": "key",
+}
+
+// logOperation logs the start of an operation with the given fields, which are extended with the organization and,
+// if the resource or data source has one, the project_key.
+func logOperation(ctx context.Context, p provider, typeName string, data attributeGetter, fields map[string]interface{}) func(*diag.Diagnostics) {
+	fields["organization"] = p.organization
+
+	projectKeyAttribute, ok := projectKeyAttributes[typeName]
+	if !ok {
+		projectKeyAttribute = "project_key"
+	}
+
+	var projectKey types.String
+	if diags := data.GetAttribute(ctx, path.Root(projectKeyAttribute), &projectKey); !diags.HasError() && !projectKey.Null && !projectKey.Unknown {
+		fields["project_key"] = projectKey.Value
+	}
+
+	tflog.Debug(ctx, "Starting operation", fields)
+	start := time.Now()
+
+	return func(diags *diag.Diagnostics) {
+		fields["latency_ms"] = time.Since(start).Milliseconds()
+		if diags.HasError() {
+			fields["error_count"] = diags.ErrorsCount()
+			tflog.Error(ctx, "Operation failed", fields)
+			return
+		}
+		tflog.Debug(ctx, "Finished operation", fields)
+	}
+}
+
+// traceEnabled checks if Terraform logs the provider at the trace level, in which case request and response bodies are logged
+func traceEnabled() bool {
+	return strings.EqualFold(os.Getenv("TF_LOG_PROVIDER"), "TRACE") ||
+		(os.Getenv("TF_LOG_PROVIDER") == "" && strings.EqualFold(os.Getenv("TF_LOG"), "TRACE"))
+}
+
+// loggingTransport logs every request to the API with its status code and latency.
+// In trace mode, it also logs the request and response bodies with sensitive values redacted.
+type loggingTransport struct {
+	ctx   context.Context
+	trace bool
+	next  http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fields := map[string]interface{}{
+		"method":   req.Method,
+		"endpoint": req.URL.Path,
+	}
+	if t.trace {
+		fields["query"] = redactForm(req.URL.RawQuery)
+		if body, ok := requestBody(req); ok {
+			fields["request_body"] = redactForm(body)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(t.ctx, "Request to the SonarCloud API failed", fields)
+		return resp, err
+	}
+
+	fields["status_code"] = resp.StatusCode
+	if t.trace {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read response body: %w", err)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		fields["response_body"] = redactJSON(body)
+		tflog.Trace(t.ctx, "Sent request to the SonarCloud API", fields)
+		return resp, nil
+	}
+
+	tflog.Debug(t.ctx, "Sent request to the SonarCloud API", fields)
+	return resp, nil
+}
+
+// requestBody returns a copy of the body of the request, if it has one that can be copied
+func requestBody(req *http.Request) (string, bool) {
+	if req.Body == nil || req.GetBody == nil {
+		return "", false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "", false
+	}
+	defer body.Close()
+
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+// redactForm returns the URL encoded form with the values of sensitive parameters redacted
+func redactForm(form string) string {
+	values, err := url.ParseQuery(form)
+	if err != nil {
+		return truncate(form)
+	}
+
+	for key := range values {
+		if sensitiveFields[strings.ToLower(key)] {
+			values[key] = []string{redacted}
+		}
+	}
+	return truncate(values.Encode())
+}
+
+// redactJSON returns the JSON document with the values of sensitive fields redacted at any depth.
+// A body that is not JSON is returned as is, because the API only returns other content for errors.
+func redactJSON(body []byte) string {
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return truncate(string(body))
+	}
+
+	result, err := json.Marshal(redactValue(document))
+	if err != nil {
+		return truncate(string(body))
+	}
+	return truncate(string(result))
+}
+
+// redactValue redacts the values of sensitive fields in the decoded JSON value
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if sensitiveFields[strings.ToLower(key)] {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactValue(element)
+		}
+	}
+	return value
+}
+
+// truncate limits the logged body to maxTracedBodySize bytes
+func truncate(body string) string {
+	if len(body) <= maxTracedBodySize {
+		return body
+	}
+	return body[:maxTracedBodySize] + "...(truncated)"
+}
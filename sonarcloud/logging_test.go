@@ -0,0 +1,96 @@
+package sonarcloud
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogResourceOperationProjectKey(t *testing.T) {
+	tests := map[string]struct {
+		resourceType tfsdk.ResourceType
+		typeName     string
+		attribute    string
+	}{
+		"project":          {resourceType: resourceProjectType{}, typeName: "sonarcloud_project", attribute: "key"},
+		"imported project": {resourceType: resourceImportedProjectType{}, typeName: "sonarcloud_imported_project", attribute: "key"},
+		"project setting":  {resourceType: resourceProjectSettingType{}, typeName: "sonarcloud_project_setting", attribute: "project_key"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+
+			schema, diags := test.resourceType.GetSchema(ctx)
+			if diags.HasError() {
+				t.Fatalf("could not get the schema: %v", diags)
+			}
+			state := tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)}
+			if diags := state.SetAttribute(ctx, path.Root(test.attribute), "example-project"); diags.HasError() {
+				t.Fatalf("could not set the %s: %v", test.attribute, diags)
+			}
+
+			logResourceOperation(ctx, provider{organization: "example-org"}, test.typeName, "read", state)(&diag.Diagnostics{})
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+			if err != nil {
+				t.Fatalf("could not decode the log: %v", err)
+			}
+			if len(entries) != 2 {
+				t.Fatalf("expected a start and an end entry, got: %v", entries)
+			}
+			for _, entry := range entries {
+				if entry["project_key"] != "example-project" {
+					t.Errorf("expected the project_key to be logged, got: %v", entry)
+				}
+			}
+		})
+	}
+}
+
+func TestRedactForm(t *testing.T) {
+	tests := map[string]struct {
+		form     string
+		expected string
+	}{
+		"empty":            {form: "", expected: ""},
+		"nothing to hide":  {form: "name=test&organization=org", expected: "name=test&organization=org"},
+		"secret":           {form: "name=hook&secret=s3cr3t", expected: "name=hook&secret=REDACTED"},
+		"case insensitive": {form: "Token=abc", expected: "Token=REDACTED"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if result := redactForm(test.form); result != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"nothing to hide": {body: `{"key":"project"}`, expected: `{"key":"project"}`},
+		"token":           {body: `{"login":"user","token":"abc"}`, expected: `{"login":"user","token":"REDACTED"}`},
+		"nested":          {body: `{"webhooks":[{"name":"hook","secret":"s3cr3t"}]}`, expected: `{"webhooks":[{"name":"hook","secret":"REDACTED"}]}`},
+		"not JSON":        {body: `Bad Gateway`, expected: `Bad Gateway`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if result := redactJSON([]byte(test.body)); result != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
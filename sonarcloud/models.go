@@ -47,8 +47,17 @@ type Token struct {
 
 // Projects represents a collection of SonarCloud projects.
 type Projects struct {
-	ID       types.String `tfsdk:"id"`
-	Projects []Project    `tfsdk:"projects"`
+	ID       types.String  `tfsdk:"id"`
+	Tag      types.String  `tfsdk:"tag"`
+	Projects []DataProject `tfsdk:"projects"`
+}
+
+// DataProject represents a single SonarCloud project data.
+type DataProject struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Key        types.String `tfsdk:"key"`
+	Visibility types.String `tfsdk:"visibility"`
 }
 
 // Project represents a single SonarCloud project.
@@ -57,6 +66,14 @@ type Project struct {
 	Name       types.String `tfsdk:"name"`
 	Key        types.String `tfsdk:"key"`
 	Visibility types.String `tfsdk:"visibility"`
+	Tags       types.Set    `tfsdk:"tags"`
+
+	SourceExclusions      types.Set `tfsdk:"source_exclusions"`
+	SourceInclusions      types.Set `tfsdk:"source_inclusions"`
+	TestExclusions        types.Set `tfsdk:"test_exclusions"`
+	TestInclusions        types.Set `tfsdk:"test_inclusions"`
+	CoverageExclusions    types.Set `tfsdk:"coverage_exclusions"`
+	DuplicationExclusions types.Set `tfsdk:"duplication_exclusions"`
 }
 
 // ProjectMainBranch represents the main branch configuration for a project.
@@ -82,6 +99,18 @@ type Conditions struct {
 
 // QualityGate represents a SonarCloud quality gate with its conditions.
 type QualityGate struct {
+	ID                  types.String  `tfsdk:"id"`
+	GateId              types.Float64 `tfsdk:"gate_id"` //nolint:revive // Field name matches Terraform schema
+	CopyFrom            types.String  `tfsdk:"copy_from"`
+	Conditions          []Condition   `tfsdk:"conditions"`
+	InheritedConditions types.List    `tfsdk:"inherited_conditions"`
+	IsBuiltIn           types.Bool    `tfsdk:"is_built_in"`
+	IsDefault           types.Bool    `tfsdk:"is_default"`
+	Name                types.String  `tfsdk:"name"`
+}
+
+// DataQualityGate represents a single quality gate data.
+type DataQualityGate struct {
 	ID         types.String  `tfsdk:"id"`
 	GateId     types.Float64 `tfsdk:"gate_id"` //nolint:revive // Field name matches Terraform schema
 	Conditions []Condition   `tfsdk:"conditions"`
@@ -92,8 +121,27 @@ type QualityGate struct {
 
 // QualityGates represents a collection of quality gates.
 type QualityGates struct {
-	ID           types.String  `tfsdk:"id"`
-	QualityGates []QualityGate `tfsdk:"quality_gates"`
+	ID           types.String      `tfsdk:"id"`
+	QualityGates []DataQualityGate `tfsdk:"quality_gates"`
+}
+
+// QualityProfile represents a SonarCloud quality profile.
+type QualityProfile struct {
+	ID        types.String         `tfsdk:"id"`
+	Key       types.String         `tfsdk:"key"`
+	Name      types.String         `tfsdk:"name"`
+	Language  types.String         `tfsdk:"language"`
+	CopyFrom  types.String         `tfsdk:"copy_from"`
+	IsBuiltIn types.Bool           `tfsdk:"is_built_in"`
+	IsDefault types.Bool           `tfsdk:"is_default"`
+	Rules     []QualityProfileRule `tfsdk:"rules"`
+}
+
+// QualityProfileRule represents the activation of a rule in a quality profile.
+type QualityProfileRule struct {
+	Rule     types.String `tfsdk:"rule"`
+	Severity types.String `tfsdk:"severity"`
+	Params   types.Map    `tfsdk:"params"`
 }
 
 // Selection represents a quality gate selection for projects.
@@ -103,6 +151,58 @@ type Selection struct {
 	ProjectKeys types.Set    `tfsdk:"project_keys"`
 }
 
+// DataQualityProfiles represents a collection of quality profiles.
+type DataQualityProfiles struct {
+	ID              types.String         `tfsdk:"id"`
+	Language        types.String         `tfsdk:"language"`
+	DefaultsOnly    types.Bool           `tfsdk:"defaults_only"`
+	QualityProfiles []DataQualityProfile `tfsdk:"quality_profiles"`
+}
+
+// DataQualityProfile represents a single quality profile data.
+type DataQualityProfile struct {
+	Key             types.String `tfsdk:"key"`
+	Name            types.String `tfsdk:"name"`
+	Language        types.String `tfsdk:"language"`
+	LanguageName    types.String `tfsdk:"language_name"`
+	IsDefault       types.Bool   `tfsdk:"is_default"`
+	IsBuiltIn       types.Bool   `tfsdk:"is_built_in"`
+	IsInherited     types.Bool   `tfsdk:"is_inherited"`
+	ParentKey       types.String `tfsdk:"parent_key"`
+	ActiveRuleCount types.Int64  `tfsdk:"active_rule_count"`
+}
+
+// DataRules represents a collection of rules.
+type DataRules struct {
+	ID         types.String `tfsdk:"id"`
+	Language   types.String `tfsdk:"language"`
+	Repository types.String `tfsdk:"repository"`
+	Type       types.String `tfsdk:"type"`
+	Severity   types.String `tfsdk:"severity"`
+	Tag        types.String `tfsdk:"tag"`
+	Rules      []DataRule   `tfsdk:"rules"`
+}
+
+// DataRule represents a single rule data.
+type DataRule struct {
+	Key        types.String `tfsdk:"key"`
+	Name       types.String `tfsdk:"name"`
+	Language   types.String `tfsdk:"language"`
+	Repository types.String `tfsdk:"repository"`
+	Type       types.String `tfsdk:"type"`
+	Severity   types.String `tfsdk:"severity"`
+	Status     types.String `tfsdk:"status"`
+	Tags       types.Set    `tfsdk:"tags"`
+}
+
+// QualityProfileSelection represents a quality profile selection for projects.
+type QualityProfileSelection struct {
+	ID          types.String `tfsdk:"id"`
+	ProfileKey  types.String `tfsdk:"profile_key"`
+	Language    types.String `tfsdk:"language"`
+	ProjectKeys types.Set    `tfsdk:"project_keys"`
+}
+
 // DataUserGroupPermissionsGroup represents group permissions data.
 type DataUserGroupPermissionsGroup struct {
 	ID          types.String `tfsdk:"id"`
@@ -199,3 +299,143 @@ type Webhook struct {
 	Secret  types.String `tfsdk:"secret"`
 	Url     types.String `tfsdk:"url"` //nolint:revive // Field name matches Terraform schema
 }
+
+// ProjectSetting represents a setting of an organization or project.
+type ProjectSetting struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectKey  types.String `tfsdk:"project_key"`
+	Key         types.String `tfsdk:"key"`
+	Value       types.String `tfsdk:"value"`
+	Values      types.List   `tfsdk:"values"`
+	FieldValues types.List   `tfsdk:"field_values"`
+}
+
+// NewCodePeriod represents the new code definition of an organization, project or branch.
+type NewCodePeriod struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Branch     types.String `tfsdk:"branch"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+}
+
+// DataNewCodePeriod represents the effective new code definition of an organization, project or branch.
+type DataNewCodePeriod struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Branch     types.String `tfsdk:"branch"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+	Inherited  types.Bool   `tfsdk:"inherited"`
+}
+
+// ProjectGithubBinding represents the binding of a project to a GitHub repository.
+type ProjectGithubBinding struct {
+	ID                    types.String `tfsdk:"id"`
+	ProjectKey            types.String `tfsdk:"project_key"`
+	Repository            types.String `tfsdk:"repository"`
+	SummaryCommentEnabled types.Bool   `tfsdk:"summary_comment_enabled"`
+	Monorepo              types.Bool   `tfsdk:"monorepo"`
+}
+
+// ProjectAzureBinding represents the binding of a project to an Azure DevOps repository.
+type ProjectAzureBinding struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectKey     types.String `tfsdk:"project_key"`
+	ProjectName    types.String `tfsdk:"project_name"`
+	RepositoryName types.String `tfsdk:"repository_name"`
+	Monorepo       types.Bool   `tfsdk:"monorepo"`
+}
+
+// ProjectRepositoryBinding represents the binding of a project to a Bitbucket Cloud or GitLab repository.
+type ProjectRepositoryBinding struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Repository types.String `tfsdk:"repository"`
+	Monorepo   types.Bool   `tfsdk:"monorepo"`
+}
+
+// ImportedProject represents a project that is provisioned from an ALM repository.
+type ImportedProject struct {
+	ID         types.String `tfsdk:"id"`
+	Alm        types.String `tfsdk:"alm"`
+	Repository types.String `tfsdk:"repository"`
+	Key        types.String `tfsdk:"key"`
+	Name       types.String `tfsdk:"name"`
+	Visibility types.String `tfsdk:"visibility"`
+}
+
+// ProjectBranch represents a non-main branch of a project.
+type ProjectBranch struct {
+	ID                types.String `tfsdk:"id"`
+	ProjectKey        types.String `tfsdk:"project_key"`
+	Name              types.String `tfsdk:"name"`
+	KeepWhenInactive  types.Bool   `tfsdk:"keep_when_inactive"`
+	Type              types.String `tfsdk:"type"`
+	QualityGateStatus types.String `tfsdk:"quality_gate_status"`
+	AnalysisDate      types.String `tfsdk:"analysis_date"`
+}
+
+// DataProjectBranches represents a collection of project branches.
+type DataProjectBranches struct {
+	ID         types.String        `tfsdk:"id"`
+	ProjectKey types.String        `tfsdk:"project_key"`
+	Branches   []DataProjectBranch `tfsdk:"branches"`
+}
+
+// DataProjectBranch represents a single project branch data.
+type DataProjectBranch struct {
+	Name              types.String `tfsdk:"name"`
+	IsMain            types.Bool   `tfsdk:"is_main"`
+	Type              types.String `tfsdk:"type"`
+	KeepWhenInactive  types.Bool   `tfsdk:"keep_when_inactive"`
+	QualityGateStatus types.String `tfsdk:"quality_gate_status"`
+	AnalysisDate      types.String `tfsdk:"analysis_date"`
+	Bugs              types.Int64  `tfsdk:"bugs"`
+	Vulnerabilities   types.Int64  `tfsdk:"vulnerabilities"`
+	CodeSmells        types.Int64  `tfsdk:"code_smells"`
+}
+
+// LongLivedBranchesPattern represents the pattern of long-lived branches of the organization or a project.
+type LongLivedBranchesPattern struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Regex      types.String `tfsdk:"regex"`
+}
+
+// DataProjectStatus represents the quality gate status and measures of a project, branch or pull request.
+type DataProjectStatus struct {
+	ID                types.String             `tfsdk:"id"`
+	ProjectKey        types.String             `tfsdk:"project_key"`
+	Branch            types.String             `tfsdk:"branch"`
+	PullRequest       types.String             `tfsdk:"pull_request"`
+	MetricKeys        types.Set                `tfsdk:"metric_keys"`
+	QualityGateStatus types.String             `tfsdk:"quality_gate_status"`
+	Conditions        []ProjectStatusCondition `tfsdk:"conditions"`
+	Measures          types.Map                `tfsdk:"measures"`
+}
+
+// ProjectStatusCondition represents an evaluated quality gate condition.
+// It shares the metric, op and error attributes of Condition, but has no ID of its own.
+type ProjectStatusCondition struct {
+	Metric      types.String `tfsdk:"metric"`
+	Op          types.String `tfsdk:"op"`
+	Error       types.String `tfsdk:"error"`
+	ActualValue types.String `tfsdk:"actual_value"`
+	Status      types.String `tfsdk:"status"`
+}
+
+// DataMetrics represents a collection of metrics.
+type DataMetrics struct {
+	ID      types.String `tfsdk:"id"`
+	Metrics []DataMetric `tfsdk:"metrics"`
+}
+
+// DataMetric represents a single metric.
+type DataMetric struct {
+	Key       types.String `tfsdk:"key"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	Domain    types.String `tfsdk:"domain"`
+	Direction types.Int64  `tfsdk:"direction"`
+}
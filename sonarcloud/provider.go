@@ -2,11 +2,15 @@ package sonarcloud
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud"
 )
 
@@ -37,6 +41,42 @@ func (p *provider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics)
 				Description: "The token of a user with admin permissions in the organization. This value must be set in" +
 					" the `SONARCLOUD_TOKEN` environment variable if left empty.",
 			},
+			"base_url": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "The URL of the SonarCloud instance, e.g. `https://sonarqube.us` for the US region. Defaults to" +
+					" `https://sonarcloud.io`. This value is read from the `SONARCLOUD_BASE_URL` environment variable if left empty.",
+			},
+			"http_timeout": {
+				Type:     types.Int64Type,
+				Optional: true,
+				Description: "The time limit in seconds for a request to the API including its retries, or `0` for no limit. Defaults to `60`." +
+					" This value is read from the `SONARCLOUD_HTTP_TIMEOUT` environment variable if left empty.",
+			},
+			"proxy_url": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "The URL of the proxy to send requests to the API through. Defaults to the proxy in the `HTTPS_PROXY`" +
+					" environment variable. This value is read from the `SONARCLOUD_PROXY_URL` environment variable if left empty.",
+			},
+			"ca_bundle": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "The path to a file with PEM encoded certificates to trust in addition to the system certificates," +
+					" e.g. for a proxy that intercepts TLS. This value is read from the `SONARCLOUD_CA_BUNDLE` environment variable if left empty.",
+			},
+			"max_retries": {
+				Type:     types.Int64Type,
+				Optional: true,
+				Description: "The number of times a request is retried when the API is rate limited or temporarily unavailable." +
+					" Defaults to `3`. This value is read from the `SONARCLOUD_MAX_RETRIES` environment variable if left empty.",
+			},
+			"retry_max_wait": {
+				Type:     types.Int64Type,
+				Optional: true,
+				Description: "The maximum time in seconds to wait before retrying a request, also when the API asks to wait longer" +
+					" with a `Retry-After` header. Defaults to `30`. This value is read from the `SONARCLOUD_RETRY_MAX_WAIT` environment variable if left empty.",
+			},
 		},
 	}, nil
 }
@@ -78,7 +118,28 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		token = config.Token.Value
 	}
 
-	c := sonarcloud.NewClient(organization, token, nil)
+	clientConfig, ok := httpClientConfigFrom(config, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Add the organization to the logs of all requests, and make sure the token never ends up in them
+	ctx = tflog.SetField(ctx, "organization", organization)
+	if token != "" {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, token)
+		ctx = tflog.MaskMessageStrings(ctx, token)
+	}
+
+	httpClient, err := newHTTPClient(ctx, clientConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create client",
+			fmt.Sprintf("Could not create the HTTP client: %+v", err),
+		)
+		return
+	}
+
+	c := sonarcloud.NewClient(organization, token, httpClient)
 	p.client = c
 	p.organization = organization
 	p.configured = true
@@ -86,24 +147,39 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 
 func (p *provider) GetResources(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
-		"sonarcloud_user_group":             resourceUserGroupType{},
-		"sonarcloud_user_group_member":      resourceUserGroupMemberType{},
-		"sonarcloud_project":                resourceProjectType{},
-		"sonarcloud_project_link":           resourceProjectLinkType{},
-		"sonarcloud_project_main_branch":    resourceProjectMainBranchType{},
-		"sonarcloud_user_token":             resourceUserTokenType{},
-		"sonarcloud_quality_gate":           resourceQualityGateType{},
-		"sonarcloud_quality_gate_selection": resourceQualityGateSelectionType{},
-		"sonarcloud_user_permissions":       resourceUserPermissionsType{},
-		"sonarcloud_user_group_permissions": resourceUserGroupPermissionsType{},
-		"sonarcloud_webhook":                resourceWebhookType{},
+		"sonarcloud_user_group":                  resourceUserGroupType{},
+		"sonarcloud_user_group_member":           resourceUserGroupMemberType{},
+		"sonarcloud_imported_project":            resourceImportedProjectType{},
+		"sonarcloud_long_lived_branches_pattern": resourceLongLivedBranchesPatternType{},
+		"sonarcloud_project":                     resourceProjectType{},
+		"sonarcloud_project_azure_binding":       resourceProjectAzureBindingType{},
+		"sonarcloud_project_bitbucket_binding":   resourceProjectBitbucketBindingType{},
+		"sonarcloud_project_branch":              resourceProjectBranchType{},
+		"sonarcloud_project_github_binding":      resourceProjectGithubBindingType{},
+		"sonarcloud_project_gitlab_binding":      resourceProjectGitlabBindingType{},
+		"sonarcloud_project_link":                resourceProjectLinkType{},
+		"sonarcloud_project_main_branch":         resourceProjectMainBranchType{},
+		"sonarcloud_project_setting":             resourceProjectSettingType{},
+		"sonarcloud_new_code_period":             resourceNewCodePeriodType{},
+		"sonarcloud_user_token":                  resourceUserTokenType{},
+		"sonarcloud_quality_gate":                resourceQualityGateType{},
+		"sonarcloud_quality_gate_selection":      resourceQualityGateSelectionType{},
+		"sonarcloud_quality_profile":             resourceQualityProfileType{},
+		"sonarcloud_quality_profile_selection":   resourceQualityProfileSelectionType{},
+		"sonarcloud_user_permissions":            resourceUserPermissionsType{},
+		"sonarcloud_user_group_permissions":      resourceUserGroupPermissionsType{},
+		"sonarcloud_webhook":                     resourceWebhookType{},
 	}, nil
 }
 
 func (p *provider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	return map[string]tfsdk.DataSourceType{
 		"sonarcloud_projects":               dataSourceProjectsType{},
+		"sonarcloud_metrics":                dataSourceMetricsType{},
+		"sonarcloud_project_branches":       dataSourceProjectBranchesType{},
+		"sonarcloud_project_status":         dataSourceProjectStatusType{},
 		"sonarcloud_project_links":          dataSourceProjectLinksType{},
+		"sonarcloud_new_code_period":        dataSourceNewCodePeriodType{},
 		"sonarcloud_user_group":             dataSourceUserGroupType{},
 		"sonarcloud_user_groups":            dataSourceUserGroupsType{},
 		"sonarcloud_user_group_members":     dataSourceUserGroupMembersType{},
@@ -111,6 +187,8 @@ func (p *provider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourc
 		"sonarcloud_user_permissions":       dataSourceUserPermissionsType{},
 		"sonarcloud_quality_gate":           dataSourceQualityGateType{},
 		"sonarcloud_quality_gates":          dataSourceQualityGatesType{},
+		"sonarcloud_quality_profiles":       dataSourceQualityProfilesType{},
+		"sonarcloud_rules":                  dataSourceRulesType{},
 		"sonarcloud_webhooks":               dataSourceWebhooksType{},
 	}, nil
 }
@@ -118,4 +196,73 @@ func (p *provider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourc
 type providerData struct {
 	Organization types.String `tfsdk:"organization"`
 	Token        types.String `tfsdk:"token"`
+	BaseURL      types.String `tfsdk:"base_url"`
+	HTTPTimeout  types.Int64  `tfsdk:"http_timeout"`
+	ProxyURL     types.String `tfsdk:"proxy_url"`
+	CABundle     types.String `tfsdk:"ca_bundle"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
+}
+
+// httpClientConfigFrom returns the settings of the HTTP client from the provider configuration, with fallbacks to the environment
+func httpClientConfigFrom(config providerData, diags *diag.Diagnostics) (httpClientConfig, bool) {
+	if config.BaseURL.Unknown || config.HTTPTimeout.Unknown || config.ProxyURL.Unknown || config.CABundle.Unknown ||
+		config.MaxRetries.Unknown || config.RetryMaxWait.Unknown {
+		diags.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as base_url, http_timeout, proxy_url, ca_bundle, max_retries or retry_max_wait",
+		)
+		return httpClientConfig{}, false
+	}
+
+	timeout := int64OrEnv(config.HTTPTimeout, "SONARCLOUD_HTTP_TIMEOUT", "http_timeout", int64(defaultHTTPTimeout/time.Second), diags)
+	maxRetries := int64OrEnv(config.MaxRetries, "SONARCLOUD_MAX_RETRIES", "max_retries", defaultMaxRetries, diags)
+	retryMaxWait := int64OrEnv(config.RetryMaxWait, "SONARCLOUD_RETRY_MAX_WAIT", "retry_max_wait", int64(defaultRetryMaxWait/time.Second), diags)
+	if diags.HasError() {
+		return httpClientConfig{}, false
+	}
+
+	return httpClientConfig{
+		BaseURL:      stringOrEnv(config.BaseURL, "SONARCLOUD_BASE_URL"),
+		Timeout:      time.Duration(timeout) * time.Second,
+		ProxyURL:     stringOrEnv(config.ProxyURL, "SONARCLOUD_PROXY_URL"),
+		CABundle:     stringOrEnv(config.CABundle, "SONARCLOUD_CA_BUNDLE"),
+		MaxRetries:   int(maxRetries),
+		RetryMaxWait: time.Duration(retryMaxWait) * time.Second,
+	}, true
+}
+
+// stringOrEnv returns the configured value, or the value of the environment variable if it is not configured
+func stringOrEnv(value types.String, env string) string {
+	if value.Null {
+		return os.Getenv(env)
+	}
+	return value.Value
+}
+
+// int64OrEnv returns the configured value, the value of the environment variable if it is not configured, or the default if neither is set.
+// The value must not be negative.
+func int64OrEnv(value types.Int64, env, name string, def int64, diags *diag.Diagnostics) int64 {
+	result := def
+	if !value.Null {
+		result = value.Value
+	} else if v := os.Getenv(env); v != "" {
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			diags.AddError(
+				"Unable to create client",
+				fmt.Sprintf("%s must be a whole number, got %q", env, v),
+			)
+			return def
+		}
+		result = parsed
+	}
+
+	if result < 0 {
+		diags.AddError(
+			"Unable to create client",
+			fmt.Sprintf("The %s must not be negative, got %d", name, result),
+		)
+	}
+	return result
 }
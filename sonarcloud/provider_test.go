@@ -15,6 +15,9 @@ import (
 
 var testAccProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
 
+// testAccFakeAPI is the fake SonarCloud API that the acceptance tests run against, if no SONARCLOUD_TOKEN is set
+var testAccFakeAPI *fakeSonarCloud
+
 func init() {
 	testAccProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"sonarcloud": providerserver.NewProtocol6WithError(New()),
@@ -43,6 +46,14 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("SONARCLOUD_TOKEN must be set for acceptance tests")
 	}
 }
+
+// testAccPreCheckLiveAPI skips tests that rely on parts of the SonarCloud API that the fake API does not implement
+func testAccPreCheckLiveAPI(t *testing.T) {
+	t.Helper()
+	if testAccFakeAPI != nil {
+		t.Skip("Skipping test against the fake SonarCloud API, set SONARCLOUD_TOKEN to run it against SonarCloud")
+	}
+}
 func TestMain(m *testing.M) {
 	// make sure .env loading is tried before test functions are defined
 	envFile := filepath.Join(repoRoot(), ".env")
@@ -54,5 +65,19 @@ func TestMain(m *testing.M) {
 			os.Exit(2)
 		}
 	}
-	os.Exit(m.Run())
+
+	// Without a token, the acceptance tests run against a fake API with in-memory state
+	if os.Getenv("SONARCLOUD_TOKEN") == "" {
+		testAccFakeAPI = newFakeSonarCloud()
+		if err := testAccFakeAPI.setEnv(); err != nil {
+			fmt.Printf("Could not configure the fake SonarCloud API: %v", err)
+			os.Exit(2)
+		}
+	}
+
+	code := m.Run()
+	if testAccFakeAPI != nil {
+		testAccFakeAPI.Close()
+	}
+	os.Exit(code)
 }
@@ -0,0 +1,256 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
+)
+
+type resourceImportedProjectType struct{}
+
+func (r resourceImportedProjectType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource provisions a project from an existing ALM repository. The key and name of the project, " +
+			"and its binding to the repository, are taken from the repository in a single step. " +
+			"The project is replaced when it is no longer bound to the repository.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Description: "The implicit ID of the resource, equal to the key of the project.",
+				Computed:    true,
+			},
+			"alm": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The ALM of the repository. Must be one of: github, azure, bitbucketcloud, gitlab.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					allowedOptions("github", "azure", "bitbucketcloud", "gitlab"),
+				},
+			},
+			"repository": {
+				Type:     types.StringType,
+				Required: true,
+				Description: "The identifier of the repository as known by the ALM, e.g. `owner/repository` for GitHub." +
+					" **Warning:** forces project recreation when changed.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"key": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The key of the project, derived from the repository.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The name of the project, derived from the repository.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"visibility": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The visibility of the project, derived from the repository.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourceImportedProjectType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceImportedProject{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceImportedProject struct {
+	p provider
+}
+
+func (r resourceImportedProject) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_imported_project", "create", req.Plan)(&resp.Diagnostics)
+
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ImportedProject
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := ImportedProjectProvisionRequest{
+		InstallationKeys: plan.Repository.Value,
+		Organization:     r.p.organization,
+	}
+	res, err := sonarcloud.PostWithResponse[ImportedProjectProvisionRequest, ImportedProjectProvisionResponse](r.p.client, "/alm_integration/provision_projects", request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not import the project",
+			fmt.Sprintf("The ProvisionProjects request returned an error: %+v", err),
+		)
+		return
+	}
+	if len(res.Projects) != 1 {
+		resp.Diagnostics.AddError(
+			"Could not import the project",
+			fmt.Sprintf("Expected exactly one project to be provisioned for repository '%s', got: %d", plan.Repository.Value, len(res.Projects)),
+		)
+		return
+	}
+
+	result, ok := r.find(res.Projects[0].ProjectKey, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Could not find the imported project",
+				fmt.Sprintf("The project '%s' was not found after it was imported", res.Projects[0].ProjectKey),
+			)
+		}
+		return
+	}
+
+	// The state is set regardless, so the project is tainted and replaced instead of left behind when its binding does not match
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+
+	if result.Alm.Value != plan.Alm.Value || result.Repository.Value != plan.Repository.Value {
+		binding := "is not bound to a repository"
+		if result.Repository.Value != "" {
+			binding = fmt.Sprintf("is bound to %s repository '%s'", result.Alm.Value, result.Repository.Value)
+		}
+		resp.Diagnostics.AddError(
+			"The imported project is not bound to the repository",
+			fmt.Sprintf("The project '%s' was imported, but %s instead of %s repository '%s'. "+
+				"Check that the repository is the installation key of the repository as known by the ALM.",
+				result.Key.Value, binding, plan.Alm.Value, plan.Repository.Value),
+		)
+	}
+}
+
+func (r resourceImportedProject) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_imported_project", "read", req.State)(&resp.Diagnostics)
+
+	var state ImportedProject
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.find(state.Key.Value, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceImportedProject) Update(_ context.Context, _ tfsdk.UpdateResourceRequest, _ *tfsdk.UpdateResourceResponse) {
+	// NOOP, we always need to recreate
+}
+
+func (r resourceImportedProject) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_imported_project", "delete", req.State)(&resp.Diagnostics)
+
+	var state ImportedProject
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := projects.DeleteRequest{
+		Project: state.Key.Value,
+	}
+	err := r.p.client.Projects.Delete(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not delete the project",
+			fmt.Sprintf("The Delete request returned an error: %+v", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceImportedProject) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+// find returns the project with its current binding.
+// When the project is no longer bound, the alm and repository are empty, which causes the project to be replaced.
+func (r resourceImportedProject) find(key string, diags *diag.Diagnostics) (ImportedProject, bool) {
+	request := projects.SearchRequest{
+		Projects: key,
+	}
+	response, err := r.p.client.Projects.SearchAll(request)
+	if err != nil {
+		diags.AddError(
+			"Could not read the project",
+			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
+		)
+		return ImportedProject{}, false
+	}
+
+	project, ok := findProject(response, key)
+	if !ok {
+		return ImportedProject{}, false
+	}
+
+	result := ImportedProject{
+		ID:         project.ID,
+		Alm:        types.String{Value: ""},
+		Repository: types.String{Value: ""},
+		Key:        project.Key,
+		Name:       project.Name,
+		Visibility: project.Visibility,
+	}
+	if binding, ok := findProjectBinding(r.p.client, key, diags); ok {
+		result.Alm = types.String{Value: binding.Alm}
+		result.Repository = types.String{Value: binding.Repository}
+	}
+	return result, !diags.HasError()
+}
+
+// ImportedProjectProvisionRequest represents a request to provision projects from ALM repositories.
+type ImportedProjectProvisionRequest struct {
+	InstallationKeys string `form:"installationKeys,omitempty"`
+	Organization     string `form:"organization,omitempty"`
+}
+
+// ImportedProjectProvisionResponse represents the response of provisioning projects from ALM repositories.
+type ImportedProjectProvisionResponse struct {
+	Projects []struct {
+		ProjectKey string `json:"projectKey,omitempty"`
+	} `json:"projects,omitempty"`
+}
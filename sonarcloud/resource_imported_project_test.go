@@ -0,0 +1,60 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccPreCheckImportedProject(t *testing.T) {
+	t.Helper()
+	if v := os.Getenv("SONARCLOUD_IMPORT_REPOSITORY"); v == "" {
+		t.Fatal("SONARCLOUD_IMPORT_REPOSITORY must be set for acceptance tests")
+	}
+}
+
+func TestAccImportedProject(t *testing.T) {
+	repository := os.Getenv("SONARCLOUD_IMPORT_REPOSITORY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckLiveAPI(t)
+			testAccPreCheckImportedProject(t)
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportedProjectConfig("github", repository),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_imported_project.test", "alm", "github"),
+					resource.TestCheckResourceAttr("sonarcloud_imported_project.test", "repository", repository),
+					resource.TestCheckResourceAttrSet("sonarcloud_imported_project.test", "key"),
+					resource.TestCheckResourceAttrSet("sonarcloud_imported_project.test", "name"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_imported_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccImportedProjectDestroy,
+	})
+}
+
+func testAccImportedProjectDestroy(_ *terraform.State) error {
+	return nil
+}
+
+func testAccImportedProjectConfig(alm, repository string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_imported_project" "test" {
+	alm        = "%s"
+	repository = "%s"
+}
+`, alm, repository)
+}
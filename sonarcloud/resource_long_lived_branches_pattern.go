@@ -0,0 +1,215 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+// longLivedBranchesPatternKey is the key of the setting that holds the long-lived branches pattern
+const longLivedBranchesPatternKey = "sonar.branch.longLivedBranches.regex"
+
+type resourceLongLivedBranchesPatternType struct{}
+
+func (r resourceLongLivedBranchesPatternType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages the pattern of long-lived branches for the whole organization or a specific project. " +
+			"Branches matching the pattern are long-lived, and are never deleted automatically when they are inactive. " +
+			"The pattern is reset to its default value on destroy.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Description: "The implicit ID of the resource.",
+				Computed:    true,
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The key of the project to set the pattern for. If omitted, the pattern is set for the organization.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"regex": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The regular expression that matches the names of long-lived branches, e.g. `(branch|release)-.*`.",
+				Validators: []tfsdk.AttributeValidator{
+					regexPattern(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (r resourceLongLivedBranchesPatternType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceLongLivedBranchesPattern{
+		p:       *(p.(*provider)),
+		setting: resourceProjectSetting{p: *(p.(*provider))},
+	}, nil
+}
+
+// resourceLongLivedBranchesPattern manages the pattern through the setting it is stored in
+type resourceLongLivedBranchesPattern struct {
+	p       provider
+	setting resourceProjectSetting
+}
+
+func (r resourceLongLivedBranchesPattern) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_long_lived_branches_pattern", "create", req.Plan)(&resp.Diagnostics)
+
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan LongLivedBranchesPattern
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.setAndFind(ctx, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceLongLivedBranchesPattern) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_long_lived_branches_pattern", "read", req.State)(&resp.Diagnostics)
+
+	var state LongLivedBranchesPattern
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.find(state, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			// The pattern was reset outside of Terraform
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceLongLivedBranchesPattern) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_long_lived_branches_pattern", "update", req.Plan)(&resp.Diagnostics)
+
+	var plan LongLivedBranchesPattern
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.setAndFind(ctx, plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceLongLivedBranchesPattern) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_long_lived_branches_pattern", "delete", req.State)(&resp.Diagnostics)
+
+	var state LongLivedBranchesPattern
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := SettingResetRequest{
+		Component:    state.ProjectKey.Value,
+		Keys:         longLivedBranchesPatternKey,
+		Organization: r.p.organization,
+	}
+	err := sonarcloud.Post(r.p.client, "/settings/reset", request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not reset the long-lived branches pattern",
+			fmt.Sprintf("The Reset request returned an error: %+v", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceLongLivedBranchesPattern) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// The pattern of the organization is imported using the key of the organization, which is also its ID
+	if req.ID == r.p.organization {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+		return
+	}
+
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("project_key"), req, resp)
+}
+
+// setAndFind sets the planned pattern and returns the resulting pattern
+func (r resourceLongLivedBranchesPattern) setAndFind(ctx context.Context, plan LongLivedBranchesPattern, diags *diag.Diagnostics) (LongLivedBranchesPattern, bool) {
+	if !r.setting.set(ctx, r.projectSettingFrom(plan), diags) {
+		return LongLivedBranchesPattern{}, false
+	}
+
+	result, ok := r.find(plan, diags)
+	if !ok && !diags.HasError() {
+		diags.AddError(
+			"Could not find the long-lived branches pattern",
+			"The long-lived branches pattern was not found after it was set",
+		)
+	}
+	return result, ok
+}
+
+// find returns the pattern if it is set on the scope of the given pattern
+func (r resourceLongLivedBranchesPattern) find(pattern LongLivedBranchesPattern, diags *diag.Diagnostics) (LongLivedBranchesPattern, bool) {
+	setting, ok := r.setting.find(r.projectSettingFrom(pattern), diags)
+	if !ok {
+		return LongLivedBranchesPattern{}, false
+	}
+
+	id := r.p.organization
+	if pattern.ProjectKey.Value != "" {
+		id = pattern.ProjectKey.Value
+	}
+
+	return LongLivedBranchesPattern{
+		ID:         types.String{Value: id},
+		ProjectKey: pattern.ProjectKey,
+		Regex:      setting.Value,
+	}, true
+}
+
+// projectSettingFrom returns the setting that holds the pattern
+func (r resourceLongLivedBranchesPattern) projectSettingFrom(pattern LongLivedBranchesPattern) ProjectSetting {
+	return ProjectSetting{
+		ProjectKey:  pattern.ProjectKey,
+		Key:         types.String{Value: longLivedBranchesPatternKey},
+		Value:       types.String{Value: pattern.Regex.Value},
+		Values:      types.List{ElemType: types.StringType, Null: true},
+		FieldValues: types.List{ElemType: types.MapType{ElemType: types.StringType}, Null: true},
+	}
+}
@@ -0,0 +1,88 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLongLivedBranchesPattern(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLongLivedBranchesPatternConfig(projectKey, "release/(.*"),
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+			{
+				Config: testAccLongLivedBranchesPatternConfig(projectKey, "release/.*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_long_lived_branches_pattern.test", "project_key", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_long_lived_branches_pattern.test", "regex", "release/.*"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_long_lived_branches_pattern.test",
+				ImportState:       true,
+				ImportStateId:     projectKey,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLongLivedBranchesPatternConfig(projectKey, "(release|support)/.*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_long_lived_branches_pattern.test", "regex", "(release|support)/.*"),
+				),
+			},
+		},
+		CheckDestroy: testAccLongLivedBranchesPatternDestroy,
+	})
+}
+
+func TestAccLongLivedBranchesPatternOrganization(t *testing.T) {
+	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sonarcloud_long_lived_branches_pattern" "test" {
+	regex = "(release|support)/.*"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_long_lived_branches_pattern.test", "id", organization),
+					resource.TestCheckNoResourceAttr("sonarcloud_long_lived_branches_pattern.test", "project_key"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_long_lived_branches_pattern.test",
+				ImportState:       true,
+				ImportStateId:     organization,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccLongLivedBranchesPatternDestroy,
+	})
+}
+
+func testAccLongLivedBranchesPatternDestroy(_ *terraform.State) error {
+	return nil
+}
+
+func testAccLongLivedBranchesPatternConfig(projectKey, regex string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_long_lived_branches_pattern" "test" {
+	project_key = "%s"
+	regex       = "%s"
+}
+`, projectKey, regex)
+}
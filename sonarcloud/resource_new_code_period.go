@@ -0,0 +1,354 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceNewCodePeriodType struct{}
+
+func (r resourceNewCodePeriodType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages the new code definition for the whole organization, a specific project or a specific branch of a project.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Description: "The implicit ID of the resource.",
+				Computed:    true,
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The key of the project to set the new code definition for. If omitted, it is set for the organization.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"branch": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The name of the branch to set the new code definition for. Requires `project_key` to be set.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"type": {
+				Type:     types.StringType,
+				Required: true,
+				Description: "The type of the new code definition. Must be one of: PREVIOUS_VERSION, NUMBER_OF_DAYS, REFERENCE_BRANCH." +
+					" REFERENCE_BRANCH is not supported for the organization.",
+				Validators: []tfsdk.AttributeValidator{
+					allowedOptions(newCodePeriodTypes...),
+				},
+			},
+			"value": {
+				Type:     types.StringType,
+				Optional: true,
+				Description: "The value of the new code definition. Must be a number of days between 1 and 90 for NUMBER_OF_DAYS," +
+					" and the name of a branch for REFERENCE_BRANCH. Must be omitted for PREVIOUS_VERSION.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceNewCodePeriodType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceNewCodePeriod{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceNewCodePeriod struct {
+	p provider
+}
+
+func (r resourceNewCodePeriod) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config NewCodePeriod
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Branch.Null && config.ProjectKey.Null {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("branch"),
+			"Invalid new code definition",
+			"The branch can only be set together with the project_key.",
+		)
+	}
+
+	if config.Type.Unknown || config.Value.Unknown || config.ProjectKey.Unknown {
+		return
+	}
+	if err := validateNewCodePeriod(config.ProjectKey.Value, config.Type.Value, config.Value.Value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid new code definition",
+			err.Error(),
+		)
+	}
+}
+
+func (r resourceNewCodePeriod) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_new_code_period", "create", req.Plan)(&resp.Diagnostics)
+
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan NewCodePeriod
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.set(plan, &resp.Diagnostics) {
+		return
+	}
+
+	result, ok := r.find(plan, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Could not find the new code definition",
+				"The new code definition was not found after it was set",
+			)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceNewCodePeriod) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_new_code_period", "read", req.State)(&resp.Diagnostics)
+
+	var state NewCodePeriod
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.find(state, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			// The new code definition was unset outside of Terraform
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceNewCodePeriod) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_new_code_period", "update", req.Plan)(&resp.Diagnostics)
+
+	var plan NewCodePeriod
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.set(plan, &resp.Diagnostics) {
+		return
+	}
+
+	result, ok := r.find(plan, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Could not find the new code definition",
+				"The new code definition was not found after it was set",
+			)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceNewCodePeriod) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_new_code_period", "delete", req.State)(&resp.Diagnostics)
+
+	var state NewCodePeriod
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := NewCodePeriodUnsetRequest{
+		Branch:       state.Branch.Value,
+		Organization: r.p.organization,
+		Project:      state.ProjectKey.Value,
+	}
+	err := sonarcloud.Post(r.p.client, "/new_code_periods/unset", request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not unset the new code definition",
+			fmt.Sprintf("The Unset request returned an error: %+v", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceNewCodePeriod) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// The definition of the organization is imported using the key of the organization, which is also its ID
+	if req.ID == r.p.organization {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+		return
+	}
+
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) < 1 || len(idParts) > 2 || idParts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization OR project_key OR project_key,branch. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[0])...)
+	if len(idParts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), idParts[1])...)
+	}
+}
+
+// set sends the planned new code definition to SonarCloud
+func (r resourceNewCodePeriod) set(plan NewCodePeriod, diags *diag.Diagnostics) bool {
+	request := NewCodePeriodSetRequest{
+		Branch:       plan.Branch.Value,
+		Organization: r.p.organization,
+		Project:      plan.ProjectKey.Value,
+		Type:         plan.Type.Value,
+		Value:        plan.Value.Value,
+	}
+	err := sonarcloud.Post(r.p.client, "/new_code_periods/set", request)
+	if err != nil {
+		diags.AddError(
+			"Could not set the new code definition",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+		)
+		return false
+	}
+	return true
+}
+
+// find returns the new code definition if it is set on the scope of the given definition
+func (r resourceNewCodePeriod) find(period NewCodePeriod, diags *diag.Diagnostics) (NewCodePeriod, bool) {
+	response, err := showNewCodePeriod(r.p.client, period.ProjectKey.Value, period.Branch.Value)
+	if err != nil {
+		diags.AddError(
+			"Could not read the new code definition",
+			fmt.Sprintf("The Show request returned an error: %+v", err),
+		)
+		return NewCodePeriod{}, false
+	}
+
+	// An inherited definition is not set on this scope
+	if response.Inherited || response.Type == "" {
+		return NewCodePeriod{}, false
+	}
+
+	id := r.p.organization
+	if period.ProjectKey.Value != "" {
+		id = period.ProjectKey.Value
+		if period.Branch.Value != "" {
+			id = fmt.Sprintf("%s,%s", period.ProjectKey.Value, period.Branch.Value)
+		}
+	}
+
+	return NewCodePeriod{
+		ID:         types.String{Value: id},
+		ProjectKey: period.ProjectKey,
+		Branch:     period.Branch,
+		Type:       types.String{Value: response.Type},
+		Value:      types.String{Value: response.Value, Null: response.Value == ""},
+	}, true
+}
+
+// newCodePeriodTypes are the supported types of a new code definition
+var newCodePeriodTypes = []string{"PREVIOUS_VERSION", "NUMBER_OF_DAYS", "REFERENCE_BRANCH"}
+
+// validateNewCodePeriod checks if the type and value of a new code definition are a valid combination for the scope
+func validateNewCodePeriod(projectKey, periodType, value string) error {
+	switch periodType {
+	case "PREVIOUS_VERSION":
+		if value != "" {
+			return fmt.Errorf("the value must be omitted for type %s, got: %q", periodType, value)
+		}
+	case "NUMBER_OF_DAYS":
+		days, err := strconv.Atoi(value)
+		if err != nil || days < 1 || days > 90 {
+			return fmt.Errorf("the value must be a number of days between 1 and 90 for type %s, got: %q", periodType, value)
+		}
+	case "REFERENCE_BRANCH":
+		if projectKey == "" {
+			return fmt.Errorf("type %s is not supported for the organization", periodType)
+		}
+		if value == "" {
+			return fmt.Errorf("the value must be the name of a branch for type %s", periodType)
+		}
+	}
+	return nil
+}
+
+// showNewCodePeriod returns the new code definition of the given scope, which is the organization if projectKey is empty
+func showNewCodePeriod(client *sonarcloud.Client, projectKey, branch string) (*NewCodePeriodShowResponse, error) {
+	params := make([]string, 0)
+	if projectKey != "" {
+		params = append(params, "project", projectKey)
+	}
+	if branch != "" {
+		params = append(params, "branch", branch)
+	}
+	return getWithResponse[NewCodePeriodShowResponse](client, "/new_code_periods/show", params...)
+}
+
+// NewCodePeriodSetRequest represents a request to set a new code definition.
+type NewCodePeriodSetRequest struct {
+	Branch       string `form:"branch,omitempty"`
+	Organization string `form:"organization,omitempty"`
+	Project      string `form:"project,omitempty"`
+	Type         string `form:"type,omitempty"`
+	Value        string `form:"value,omitempty"`
+}
+
+// NewCodePeriodUnsetRequest represents a request to unset a new code definition.
+type NewCodePeriodUnsetRequest struct {
+	Branch       string `form:"branch,omitempty"`
+	Organization string `form:"organization,omitempty"`
+	Project      string `form:"project,omitempty"`
+}
+
+// NewCodePeriodShowResponse represents the response of showing a new code definition.
+type NewCodePeriodShowResponse struct {
+	ProjectKey string `json:"projectKey,omitempty"`
+	BranchKey  string `json:"branchKey,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Value      string `json:"value,omitempty"`
+	Inherited  bool   `json:"inherited,omitempty"`
+}
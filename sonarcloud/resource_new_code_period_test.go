@@ -0,0 +1,96 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNewCodePeriod(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLiveAPI(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNewCodePeriodConfig(projectKey, "PREVIOUS_VERSION", `"30"`),
+				ExpectError: regexp.MustCompile("the value must be omitted"),
+			},
+			{
+				Config:      testAccNewCodePeriodConfig(projectKey, "NUMBER_OF_DAYS", `"365"`),
+				ExpectError: regexp.MustCompile("between 1 and 90"),
+			},
+			{
+				Config: testAccNewCodePeriodConfig(projectKey, "NUMBER_OF_DAYS", `"30"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_new_code_period.test", "project_key", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_new_code_period.test", "type", "NUMBER_OF_DAYS"),
+					resource.TestCheckResourceAttr("sonarcloud_new_code_period.test", "value", "30"),
+				),
+			},
+			newCodePeriodImportCheck("sonarcloud_new_code_period.test", projectKey),
+			{
+				Config: testAccNewCodePeriodConfig(projectKey, "PREVIOUS_VERSION", "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_new_code_period.test", "type", "PREVIOUS_VERSION"),
+					resource.TestCheckNoResourceAttr("sonarcloud_new_code_period.test", "value"),
+				),
+			},
+			newCodePeriodImportCheck("sonarcloud_new_code_period.test", projectKey),
+		},
+		CheckDestroy: testAccNewCodePeriodDestroy,
+	})
+}
+
+func TestAccNewCodePeriodOrganization(t *testing.T) {
+	organization := os.Getenv("SONARCLOUD_ORGANIZATION")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLiveAPI(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sonarcloud_new_code_period" "test" {
+	type  = "NUMBER_OF_DAYS"
+	value = "30"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_new_code_period.test", "id", organization),
+					resource.TestCheckNoResourceAttr("sonarcloud_new_code_period.test", "project_key"),
+				),
+			},
+			newCodePeriodImportCheck("sonarcloud_new_code_period.test", organization),
+		},
+		CheckDestroy: testAccNewCodePeriodDestroy,
+	})
+}
+
+func testAccNewCodePeriodDestroy(_ *terraform.State) error {
+	return nil
+}
+
+func testAccNewCodePeriodConfig(projectKey, periodType, value string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_new_code_period" "test" {
+	project_key = "%s"
+	type        = "%s"
+	value       = %s
+}
+`, projectKey, periodType, value)
+}
+
+func newCodePeriodImportCheck(resourceName, projectKey string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     projectKey,
+		ImportStateVerify: true,
+	}
+}
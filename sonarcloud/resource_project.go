@@ -3,11 +3,16 @@ package sonarcloud
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/project_tags"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/projects"
 )
 
@@ -51,10 +56,42 @@ func (r resourceProjectType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 					allowedOptions("public", "private"),
 				},
 			},
+			"tags": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+				Computed: true,
+				Description: "The tags of the project, e.g. to group projects by team. Tags must be lowercase." +
+					" If omitted, the current tags are left unchanged.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					projectTags(),
+				},
+			},
+			"source_exclusions":      analysisScopeAttribute("Files to exclude from the analysis (`sonar.exclusions`)."),
+			"source_inclusions":      analysisScopeAttribute("Files to include in the analysis (`sonar.inclusions`)."),
+			"test_exclusions":        analysisScopeAttribute("Test files to exclude from the analysis (`sonar.test.exclusions`)."),
+			"test_inclusions":        analysisScopeAttribute("Test files to include in the analysis (`sonar.test.inclusions`)."),
+			"coverage_exclusions":    analysisScopeAttribute("Files to exclude from the coverage calculation (`sonar.coverage.exclusions`)."),
+			"duplication_exclusions": analysisScopeAttribute("Files to exclude from the duplication detection (`sonar.cpd.exclusions`)."),
 		},
 	}, nil
 }
 
+// analysisScopeAttribute returns the schema of an attribute that manages a set of file patterns of the analysis scope
+func analysisScopeAttribute(description string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Type:     types.SetType{ElemType: types.StringType},
+		Optional: true,
+		Description: description + " If omitted, the setting is not managed by Terraform." +
+			" Use an empty set to reset it to the default.",
+		Validators: []tfsdk.AttributeValidator{
+			globPatterns(),
+		},
+	}
+}
+
 func (r resourceProjectType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
 	return resourceProject{
 		p: *(p.(*provider)),
@@ -66,6 +103,8 @@ type resourceProject struct {
 }
 
 func (r resourceProject) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -100,11 +139,29 @@ func (r resourceProject) Create(ctx context.Context, req tfsdk.CreateResourceReq
 		return
 	}
 
+	if !r.setAnalysisScope(ctx, plan, nullAnalysisScope(), &resp.Diagnostics) {
+		return
+	}
+
+	if !plan.Tags.Unknown && !r.setTags(ctx, plan.Key.Value, plan.Tags, &resp.Diagnostics) {
+		return
+	}
+
 	var result = Project{
 		ID:         types.String{Value: res.Project.Key},
 		Name:       types.String{Value: res.Project.Name},
 		Key:        types.String{Value: res.Project.Key},
 		Visibility: types.String{Value: plan.Visibility.Value},
+
+		SourceExclusions:      plan.SourceExclusions,
+		SourceInclusions:      plan.SourceInclusions,
+		TestExclusions:        plan.TestExclusions,
+		TestInclusions:        plan.TestInclusions,
+		CoverageExclusions:    plan.CoverageExclusions,
+		DuplicationExclusions: plan.DuplicationExclusions,
+	}
+	if !r.readTags(&result, &resp.Diagnostics) {
+		return
 	}
 	diags = resp.State.Set(ctx, result)
 
@@ -112,6 +169,8 @@ func (r resourceProject) Create(ctx context.Context, req tfsdk.CreateResourceReq
 }
 
 func (r resourceProject) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project", "read", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Project
 	diags := req.State.Get(ctx, &state)
@@ -136,6 +195,9 @@ func (r resourceProject) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 
 	// Check if the resource exists the list of retrieved resources
 	if result, ok := findProject(response, state.Key.Value); ok {
+		if !r.readAnalysisScope(state, &result, &resp.Diagnostics) || !r.readTags(&result, &resp.Diagnostics) {
+			return
+		}
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
@@ -144,6 +206,8 @@ func (r resourceProject) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 }
 
 func (r resourceProject) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project", "update", req.Plan)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Project
 	diags := req.State.Get(ctx, &state)
@@ -160,7 +224,7 @@ func (r resourceProject) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		return
 	}
 
-	changed := changedAttrs(req, diags)
+	changed := changedAttrs(req, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -197,6 +261,16 @@ func (r resourceProject) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 		}
 	}
 
+	if !r.setAnalysisScope(ctx, plan, state, &resp.Diagnostics) {
+		return
+	}
+
+	if !plan.Tags.Unknown && !plan.Tags.Equal(state.Tags) {
+		if !r.setTags(ctx, plan.Key.Value, plan.Tags, &resp.Diagnostics) {
+			return
+		}
+	}
+
 	// We don't have a return value, so we have to query it again
 	// Fill in api action struct
 	searchRequest := projects.SearchRequest{}
@@ -212,12 +286,17 @@ func (r resourceProject) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 
 	// Check if the resource exists the list of retrieved resources
 	if result, ok := findProject(response, plan.Key.Value); ok {
+		if !r.readAnalysisScope(plan, &result, &resp.Diagnostics) || !r.readTags(&result, &resp.Diagnostics) {
+			return
+		}
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	}
 }
 
 func (r resourceProject) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project", "delete", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Project
 	diags := req.State.Get(ctx, &state)
@@ -245,3 +324,156 @@ func (r resourceProject) Delete(ctx context.Context, req tfsdk.DeleteResourceReq
 func (r resourceProject) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
 	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("key"), req, resp)
 }
+
+// setTags replaces the tags of the project with the given tags
+func (r resourceProject) setTags(ctx context.Context, key string, tags types.Set, diags *diag.Diagnostics) bool {
+	values := make([]string, 0, len(tags.Elems))
+	diags.Append(tags.ElementsAs(ctx, &values, false)...)
+	if diags.HasError() {
+		return false
+	}
+	sort.Strings(values)
+
+	request := project_tags.SetRequest{
+		Project: key,
+		Tags:    strings.Join(values, ","),
+	}
+	err := r.p.client.ProjectTags.Set(request)
+	if err != nil {
+		diags.AddError(
+			"Could not update the project tags",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+		)
+		return false
+	}
+	return true
+}
+
+// readTags fills in the current tags of the project
+func (r resourceProject) readTags(result *Project, diags *diag.Diagnostics) bool {
+	// The SDK's ShowResponse does not include the tags of the component
+	response, err := getWithResponse[ProjectShowResponse](r.p.client, "/components/show", "component", result.Key.Value)
+	if err != nil {
+		diags.AddError(
+			"Could not read the project tags",
+			fmt.Sprintf("The Show request returned an error: %+v", err),
+		)
+		return false
+	}
+
+	result.Tags = types.Set{ElemType: types.StringType, Elems: stringAttributes(response.Component.Tags)}
+	return true
+}
+
+// projectAnalysisScopeSettings maps the analysis scope attributes of a project to their setting keys
+var projectAnalysisScopeSettings = []struct {
+	key   string
+	value func(project *Project) *types.Set
+}{
+	{"sonar.exclusions", func(project *Project) *types.Set { return &project.SourceExclusions }},
+	{"sonar.inclusions", func(project *Project) *types.Set { return &project.SourceInclusions }},
+	{"sonar.test.exclusions", func(project *Project) *types.Set { return &project.TestExclusions }},
+	{"sonar.test.inclusions", func(project *Project) *types.Set { return &project.TestInclusions }},
+	{"sonar.coverage.exclusions", func(project *Project) *types.Set { return &project.CoverageExclusions }},
+	{"sonar.cpd.exclusions", func(project *Project) *types.Set { return &project.DuplicationExclusions }},
+}
+
+// nullAnalysisScope returns a project of which none of the analysis scope settings are managed
+func nullAnalysisScope() Project {
+	var project Project
+	for _, setting := range projectAnalysisScopeSettings {
+		*setting.value(&project) = types.Set{ElemType: types.StringType, Null: true}
+	}
+	return project
+}
+
+// setAnalysisScope sets or resets the analysis scope settings that differ between the state and the plan.
+// Settings that are removed from the plan, or set to an empty set, are reset to their default.
+func (r resourceProject) setAnalysisScope(ctx context.Context, plan, state Project, diags *diag.Diagnostics) bool {
+	for _, setting := range projectAnalysisScopeSettings {
+		want := *setting.value(&plan)
+		have := *setting.value(&state)
+		if want.Equal(have) {
+			continue
+		}
+
+		var err error
+		if want.Null || len(want.Elems) == 0 {
+			request := SettingResetRequest{
+				Component: plan.Key.Value,
+				Keys:      setting.key,
+			}
+			err = sonarcloud.Post(r.p.client, "/settings/reset", request)
+		} else {
+			values := make([]string, 0, len(want.Elems))
+			diags.Append(want.ElementsAs(ctx, &values, false)...)
+			if diags.HasError() {
+				return false
+			}
+			sort.Strings(values)
+
+			request := SettingSetRequest{
+				Component: plan.Key.Value,
+				Key:       setting.key,
+				Values:    values,
+			}
+			err = sonarcloud.Post(r.p.client, "/settings/set", request)
+		}
+		if err != nil {
+			diags.AddError(
+				"Could not update the project analysis scope",
+				fmt.Sprintf("The setting '%s' could not be updated: %+v", setting.key, err),
+			)
+			return false
+		}
+	}
+	return true
+}
+
+// readAnalysisScope fills in the analysis scope settings of the result, for the settings that are managed in the given state
+func (r resourceProject) readAnalysisScope(state Project, result *Project, diags *diag.Diagnostics) bool {
+	keys := make([]string, 0)
+	for _, setting := range projectAnalysisScopeSettings {
+		if !setting.value(&state).Null {
+			keys = append(keys, setting.key)
+		}
+	}
+	if len(keys) == 0 {
+		return true
+	}
+
+	response, err := getWithResponse[SettingValuesResponse](r.p.client, "/settings/values",
+		"component", result.Key.Value,
+		"keys", strings.Join(keys, ","),
+	)
+	if err != nil {
+		diags.AddError(
+			"Could not read the project analysis scope",
+			fmt.Sprintf("The Values request returned an error: %+v", err),
+		)
+		return false
+	}
+
+	for _, setting := range projectAnalysisScopeSettings {
+		if setting.value(&state).Null {
+			continue
+		}
+		values := make([]string, 0)
+		for _, s := range response.Settings {
+			// Inherited values are not set on the project itself
+			if s.Key == setting.key && !s.Inherited {
+				values = s.Values
+			}
+		}
+		*setting.value(result) = types.Set{ElemType: types.StringType, Elems: stringAttributes(values)}
+	}
+	return true
+}
+
+// ProjectShowResponse represents the response of showing a project component.
+type ProjectShowResponse struct {
+	Component struct {
+		Key  string   `json:"key,omitempty"`
+		Tags []string `json:"tags,omitempty"`
+	} `json:"component,omitempty"`
+}
@@ -0,0 +1,78 @@
+package sonarcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceProjectAzureBindingType struct{}
+
+func (r resourceProjectAzureBindingType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return projectBindingSchema("Azure DevOps", map[string]tfsdk.Attribute{
+		"project_name": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The name of the Azure DevOps project that contains the repository.",
+		},
+		"repository_name": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The name of the Azure DevOps repository.",
+		},
+	}), nil
+}
+
+func (r resourceProjectAzureBindingType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectBinding[ProjectAzureBinding]{
+		p:        *(p.(*provider)),
+		alm:      azureBindingAlm{},
+		typeName: "sonarcloud_project_azure_binding",
+	}, nil
+}
+
+type azureBindingAlm struct{}
+
+func (a azureBindingAlm) name() string {
+	return "Azure DevOps"
+}
+
+func (a azureBindingAlm) alm() string {
+	return "azure"
+}
+
+func (a azureBindingAlm) projectKey(binding ProjectAzureBinding) string {
+	return binding.ProjectKey.Value
+}
+
+func (a azureBindingAlm) set(client *sonarcloud.Client, binding ProjectAzureBinding) error {
+	request := ProjectAzureBindingRequest{
+		Monorepo:       boolOrDefault(binding.Monorepo, false),
+		Project:        binding.ProjectKey.Value,
+		ProjectName:    binding.ProjectName.Value,
+		RepositoryName: binding.RepositoryName.Value,
+	}
+	return sonarcloud.Post(client, "/alm_settings/set_azure_binding", request)
+}
+
+func (a azureBindingAlm) from(projectKey string, response *ProjectBindingResponse) ProjectAzureBinding {
+	// The binding response returns the Azure DevOps project name as the slug
+	return ProjectAzureBinding{
+		ID:             types.String{Value: projectKey},
+		ProjectKey:     types.String{Value: projectKey},
+		ProjectName:    types.String{Value: response.Slug},
+		RepositoryName: types.String{Value: response.Repository},
+		Monorepo:       types.Bool{Value: response.Monorepo},
+	}
+}
+
+// ProjectAzureBindingRequest represents a request to bind a project to an Azure DevOps repository.
+type ProjectAzureBindingRequest struct {
+	Monorepo       bool   `form:"monorepo"`
+	Project        string `form:"project,omitempty"`
+	ProjectName    string `form:"projectName,omitempty"`
+	RepositoryName string `form:"repositoryName,omitempty"`
+}
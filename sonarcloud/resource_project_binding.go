@@ -0,0 +1,256 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+// projectBindingAlm implements the ALM specific parts of a project binding resource.
+// The binding model T holds the attributes of the resource.
+type projectBindingAlm[T any] interface {
+	// name returns the display name of the ALM, e.g. GitHub
+	name() string
+	// alm returns the value of the alm field in the binding response, e.g. github
+	alm() string
+	// projectKey returns the key of the bound project
+	projectKey(binding T) string
+	// set binds the project to the repository of the binding
+	set(client *sonarcloud.Client, binding T) error
+	// from returns the binding from the binding response
+	from(projectKey string, response *ProjectBindingResponse) T
+}
+
+// projectBindingSchema returns the schema of a project binding resource, extended with the ALM specific attributes
+func projectBindingSchema(almName string, attributes map[string]tfsdk.Attribute) tfsdk.Schema {
+	attributes["id"] = tfsdk.Attribute{
+		Type:        types.StringType,
+		Description: "The implicit ID of the resource, equal to the key of the project.",
+		Computed:    true,
+	}
+	attributes["project_key"] = tfsdk.Attribute{
+		Type:        types.StringType,
+		Required:    true,
+		Description: "The key of the project to bind.",
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			tfsdk.RequiresReplace(),
+		},
+	}
+	attributes["monorepo"] = tfsdk.Attribute{
+		Type:        types.BoolType,
+		Optional:    true,
+		Computed:    true,
+		Description: "Whether the repository contains multiple projects. Defaults to `false`.",
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			tfsdk.UseStateForUnknown(),
+		},
+	}
+
+	return tfsdk.Schema{
+		Description: fmt.Sprintf("This resource binds a project to its %s repository, e.g. to enable pull request decoration.", almName),
+		Attributes:  attributes,
+	}
+}
+
+type resourceProjectBinding[T any] struct {
+	p        provider
+	alm      projectBindingAlm[T]
+	typeName string
+}
+
+func (r resourceProjectBinding[T]) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, r.typeName, "create", req.Plan)(&resp.Diagnostics)
+
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan T
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.setAndFind(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectBinding[T]) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, r.typeName, "read", req.State)(&resp.Diagnostics)
+
+	var state T
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.find(r.alm.projectKey(state), &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			// The project is no longer bound, or bound to another ALM
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectBinding[T]) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, r.typeName, "update", req.Plan)(&resp.Diagnostics)
+
+	var plan T
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.setAndFind(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectBinding[T]) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, r.typeName, "delete", req.State)(&resp.Diagnostics)
+
+	var state T
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := ProjectBindingDeleteRequest{
+		Project: r.alm.projectKey(state),
+	}
+	err := sonarcloud.Post(r.p.client, "/alm_settings/delete_binding", request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Could not delete the %s binding", r.alm.name()),
+			fmt.Sprintf("The DeleteBinding request returned an error: %+v", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceProjectBinding[T]) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("project_key"), req, resp)
+}
+
+// setAndFind binds the project as planned, replacing any existing binding, and returns the resulting binding
+func (r resourceProjectBinding[T]) setAndFind(plan T, diags *diag.Diagnostics) (T, bool) {
+	if err := r.alm.set(r.p.client, plan); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Could not set the %s binding", r.alm.name()),
+			fmt.Sprintf("The SetBinding request returned an error: %+v", err),
+		)
+		var empty T
+		return empty, false
+	}
+
+	projectKey := r.alm.projectKey(plan)
+	result, ok := r.find(projectKey, diags)
+	if !ok && !diags.HasError() {
+		diags.AddError(
+			fmt.Sprintf("Could not find the %s binding", r.alm.name()),
+			fmt.Sprintf("The project '%s' is not bound to a %s repository after it was bound", projectKey, r.alm.name()),
+		)
+	}
+	return result, ok
+}
+
+// find returns the binding of the project, if the project is bound to the ALM of this resource
+func (r resourceProjectBinding[T]) find(projectKey string, diags *diag.Diagnostics) (T, bool) {
+	binding, ok := findProjectBinding(r.p.client, projectKey, diags)
+	if !ok || binding.Alm != r.alm.alm() {
+		var empty T
+		return empty, false
+	}
+	return r.alm.from(projectKey, binding), true
+}
+
+// findProjectBinding returns the ALM binding of the project, if the project is bound
+func findProjectBinding(client *sonarcloud.Client, projectKey string, diags *diag.Diagnostics) (*ProjectBindingResponse, bool) {
+	response, err := getWithResponse[ProjectBindingResponse](client, "/alm_settings/get_binding", "project", projectKey)
+	if err != nil {
+		if !isNotFound(err) {
+			diags.AddError(
+				"Could not read the project binding",
+				fmt.Sprintf("The GetBinding request returned an error: %+v", err),
+			)
+		}
+		return nil, false
+	}
+
+	// The ALM is compared against the lowercase ALM of the binding resources
+	response.Alm = strings.ToLower(response.Alm)
+	return response, true
+}
+
+// boolOrDefault returns the value of the bool, or the default if it is null or unknown
+func boolOrDefault(value types.Bool, defaultValue bool) bool {
+	if value.Null || value.Unknown {
+		return defaultValue
+	}
+	return value.Value
+}
+
+// ProjectBindingDeleteRequest represents a request to delete the ALM binding of a project.
+type ProjectBindingDeleteRequest struct {
+	Project string `form:"project,omitempty"`
+}
+
+// ProjectBindingResponse represents the ALM binding of a project.
+type ProjectBindingResponse struct {
+	Alm                   string `json:"alm,omitempty"`
+	Key                   string `json:"key,omitempty"`
+	Repository            string `json:"repository,omitempty"`
+	Slug                  string `json:"slug,omitempty"`
+	URL                   string `json:"url,omitempty"`
+	SummaryCommentEnabled bool   `json:"summaryCommentEnabled,omitempty"`
+	Monorepo              bool   `json:"monorepo,omitempty"`
+}
+
+// projectRepositoryBindingFrom returns the binding of an ALM that only identifies the repository
+func projectRepositoryBindingFrom(projectKey string, response *ProjectBindingResponse) ProjectRepositoryBinding {
+	return ProjectRepositoryBinding{
+		ID:         types.String{Value: projectKey},
+		ProjectKey: types.String{Value: projectKey},
+		Repository: types.String{Value: response.Repository},
+		Monorepo:   types.Bool{Value: response.Monorepo},
+	}
+}
+
+// ProjectRepositoryBindingRequest represents a request to bind a project to a repository of an ALM that only needs the repository.
+type ProjectRepositoryBindingRequest struct {
+	Monorepo   bool   `form:"monorepo"`
+	Project    string `form:"project,omitempty"`
+	Repository string `form:"repository,omitempty"`
+}
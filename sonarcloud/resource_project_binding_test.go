@@ -0,0 +1,148 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+func testAccPreCheckProjectBinding(t *testing.T, variables ...string) {
+	t.Helper()
+	for _, variable := range append([]string{"SONARCLOUD_PROJECT_KEY"}, variables...) {
+		if v := os.Getenv(variable); v == "" {
+			t.Fatalf("%s must be set for acceptance tests", variable)
+		}
+	}
+}
+
+func TestFindProjectBindingAlm(t *testing.T) {
+	for _, alm := range []string{"github", "GITHUB", "GitHub"} {
+		t.Run(alm, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"alm":%q,"repository":"example-org/example-repository"}`, alm)
+			}))
+			defer server.Close()
+
+			httpClient, err := newHTTPClient(context.Background(), httpClientConfig{BaseURL: server.URL + "/"})
+			if err != nil {
+				t.Fatalf("could not create HTTP client: %+v", err)
+			}
+			client := sonarcloud.NewClient("org", "token", httpClient)
+
+			var diags diag.Diagnostics
+			binding, ok := findProjectBinding(client, "project", &diags)
+			if !ok || diags.HasError() {
+				t.Fatalf("expected the binding to be found, got: %v", diags)
+			}
+			if expected := (githubBindingAlm{}).alm(); binding.Alm != expected {
+				t.Errorf("expected the ALM to be %q, got %q", expected, binding.Alm)
+			}
+		})
+	}
+}
+
+func TestAccProjectAzureBinding(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	projectName := os.Getenv("SONARCLOUD_AZURE_PROJECT_NAME")
+	repositoryName := os.Getenv("SONARCLOUD_AZURE_REPOSITORY_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckLiveAPI(t)
+			testAccPreCheckProjectBinding(t, "SONARCLOUD_AZURE_PROJECT_NAME", "SONARCLOUD_AZURE_REPOSITORY_NAME")
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "sonarcloud_project_azure_binding" "test" {
+	project_key     = "%s"
+	project_name    = "%s"
+	repository_name = "%s"
+}
+`, projectKey, projectName, repositoryName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_azure_binding.test", "project_name", projectName),
+					resource.TestCheckResourceAttr("sonarcloud_project_azure_binding.test", "repository_name", repositoryName),
+					resource.TestCheckResourceAttr("sonarcloud_project_azure_binding.test", "monorepo", "false"),
+				),
+			},
+			projectBindingImportCheck("sonarcloud_project_azure_binding.test", projectKey),
+		},
+		CheckDestroy: testAccProjectBindingDestroy,
+	})
+}
+
+func TestAccProjectBitbucketBinding(t *testing.T) {
+	testAccProjectRepositoryBinding(t, "sonarcloud_project_bitbucket_binding", "SONARCLOUD_BITBUCKET_REPOSITORY")
+}
+
+func TestAccProjectGitlabBinding(t *testing.T) {
+	testAccProjectRepositoryBinding(t, "sonarcloud_project_gitlab_binding", "SONARCLOUD_GITLAB_REPOSITORY")
+}
+
+func testAccProjectRepositoryBinding(t *testing.T, resourceType, repositoryVariable string) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	repository := os.Getenv(repositoryVariable)
+	resourceName := resourceType + ".test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckLiveAPI(t)
+			testAccPreCheckProjectBinding(t, repositoryVariable)
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRepositoryBindingConfig(resourceType, projectKey, repository, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_key", projectKey),
+					resource.TestCheckResourceAttr(resourceName, "repository", repository),
+					resource.TestCheckResourceAttr(resourceName, "monorepo", "false"),
+				),
+			},
+			projectBindingImportCheck(resourceName, projectKey),
+			{
+				Config: testAccProjectRepositoryBindingConfig(resourceType, projectKey, repository, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "monorepo", "true"),
+				),
+			},
+		},
+		CheckDestroy: testAccProjectBindingDestroy,
+	})
+}
+
+func testAccProjectBindingDestroy(_ *terraform.State) error {
+	return nil
+}
+
+func testAccProjectRepositoryBindingConfig(resourceType, projectKey, repository string, monorepo bool) string {
+	return fmt.Sprintf(`
+resource "%s" "test" {
+	project_key = "%s"
+	repository  = "%s"
+	monorepo    = %t
+}
+`, resourceType, projectKey, repository, monorepo)
+}
+
+func projectBindingImportCheck(resourceName, projectKey string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     projectKey,
+		ImportStateVerify: true,
+	}
+}
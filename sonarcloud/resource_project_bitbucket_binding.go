@@ -0,0 +1,57 @@
+package sonarcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceProjectBitbucketBindingType struct{}
+
+func (r resourceProjectBitbucketBindingType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return projectBindingSchema("Bitbucket Cloud", map[string]tfsdk.Attribute{
+		"repository": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The slug of the Bitbucket Cloud repository.",
+		},
+	}), nil
+}
+
+func (r resourceProjectBitbucketBindingType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectBinding[ProjectRepositoryBinding]{
+		p:        *(p.(*provider)),
+		alm:      bitbucketBindingAlm{},
+		typeName: "sonarcloud_project_bitbucket_binding",
+	}, nil
+}
+
+type bitbucketBindingAlm struct{}
+
+func (a bitbucketBindingAlm) name() string {
+	return "Bitbucket Cloud"
+}
+
+func (a bitbucketBindingAlm) alm() string {
+	return "bitbucketcloud"
+}
+
+func (a bitbucketBindingAlm) projectKey(binding ProjectRepositoryBinding) string {
+	return binding.ProjectKey.Value
+}
+
+func (a bitbucketBindingAlm) set(client *sonarcloud.Client, binding ProjectRepositoryBinding) error {
+	request := ProjectRepositoryBindingRequest{
+		Monorepo:   boolOrDefault(binding.Monorepo, false),
+		Project:    binding.ProjectKey.Value,
+		Repository: binding.Repository.Value,
+	}
+	return sonarcloud.Post(client, "/alm_settings/set_bitbucketcloud_binding", request)
+}
+
+func (a bitbucketBindingAlm) from(projectKey string, response *ProjectBindingResponse) ProjectRepositoryBinding {
+	return projectRepositoryBindingFrom(projectKey, response)
+}
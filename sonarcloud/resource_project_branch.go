@@ -0,0 +1,317 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/project_branches"
+)
+
+type resourceProjectBranchType struct{}
+
+func (r resourceProjectBranchType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages a non-main branch of a project. Branches are created by analyzing them, " +
+			"so the branch must have been analyzed before it can be managed. The branch is deleted on destroy.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Description: "The implicit ID of the resource.",
+				Computed:    true,
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the project.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The name of the branch.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"keep_when_inactive": {
+				Type:        types.BoolType,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the branch is kept when it is inactive, instead of being deleted automatically.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"type": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The type of the branch, e.g. `LONG` for long-lived branches.",
+			},
+			"quality_gate_status": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The quality gate status of the last analysis of the branch.",
+			},
+			"analysis_date": {
+				Type:        types.StringType,
+				Computed:    true,
+				Description: "The date of the last analysis of the branch.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceProjectBranchType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectBranch{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceProjectBranch struct {
+	p provider
+}
+
+func (r resourceProjectBranch) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_branch", "create", req.Plan)(&resp.Diagnostics)
+
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProjectBranch
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, isMain, ok := r.find(plan.ProjectKey.Value, plan.Name.Value, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Could not find the branch",
+				fmt.Sprintf("The branch '%s' of project '%s' does not exist. Branches are created by analyzing them.", plan.Name.Value, plan.ProjectKey.Value),
+			)
+		}
+		return
+	}
+	if isMain {
+		resp.Diagnostics.AddError(
+			"Could not manage the branch",
+			fmt.Sprintf("The branch '%s' is the main branch of project '%s', use sonarcloud_project_main_branch instead.", plan.Name.Value, plan.ProjectKey.Value),
+		)
+		return
+	}
+
+	r.update(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.findBranch(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectBranch) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_branch", "read", req.State)(&resp.Diagnostics)
+
+	var state ProjectBranch
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, _, ok := r.find(state.ProjectKey.Value, state.Name.Value, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectBranch) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_branch", "update", req.Plan)(&resp.Diagnostics)
+
+	var plan ProjectBranch
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.update(plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.findBranch(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectBranch) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_branch", "delete", req.State)(&resp.Diagnostics)
+
+	var state ProjectBranch
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := project_branches.DeleteRequest{
+		Branch:  state.Name.Value,
+		Project: state.ProjectKey.Value,
+	}
+	err := r.p.client.ProjectBranches.Delete(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not delete the branch",
+			fmt.Sprintf("The Delete request returned an error: %+v", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceProjectBranch) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.SplitN(req.ID, ",", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_key,name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+// update sets the protection against automatic deletion, if it is configured
+func (r resourceProjectBranch) update(plan ProjectBranch, diags *diag.Diagnostics) {
+	if plan.KeepWhenInactive.Null || plan.KeepWhenInactive.Unknown {
+		return
+	}
+
+	request := ProjectBranchDeletionProtectionRequest{
+		Branch:  plan.Name.Value,
+		Project: plan.ProjectKey.Value,
+		Value:   plan.KeepWhenInactive.Value,
+	}
+	// The project_branches package has no request to protect a branch against automatic deletion
+	err := sonarcloud.Post(r.p.client, "/project_branches/set_automatic_deletion_protection", request)
+	if err != nil {
+		diags.AddError(
+			"Could not update the branch",
+			fmt.Sprintf("The SetAutomaticDeletionProtection request returned an error: %+v", err),
+		)
+	}
+}
+
+// findBranch returns the branch after it was updated
+func (r resourceProjectBranch) findBranch(plan ProjectBranch, diags *diag.Diagnostics) (ProjectBranch, bool) {
+	result, _, ok := r.find(plan.ProjectKey.Value, plan.Name.Value, diags)
+	if !ok {
+		if !diags.HasError() {
+			diags.AddError(
+				"Could not find the branch",
+				fmt.Sprintf("The branch '%s' of project '%s' was not found after it was updated", plan.Name.Value, plan.ProjectKey.Value),
+			)
+		}
+		return ProjectBranch{}, false
+	}
+	return result, true
+}
+
+// find returns the branch with the given name and whether it is the main branch, if it exists
+func (r resourceProjectBranch) find(projectKey, name string, diags *diag.Diagnostics) (ProjectBranch, bool, bool) {
+	response, excludedFromPurge, err := listProjectBranches(r.p.client, projectKey)
+	if err != nil {
+		diags.AddError(
+			"Could not read the branch",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+		)
+		return ProjectBranch{}, false, false
+	}
+
+	for _, branch := range response.Branches {
+		if branch.Name == name {
+			return ProjectBranch{
+				ID:                types.String{Value: fmt.Sprintf("%s,%s", projectKey, branch.Name)},
+				ProjectKey:        types.String{Value: projectKey},
+				Name:              types.String{Value: branch.Name},
+				KeepWhenInactive:  types.Bool{Value: excludedFromPurge[branch.Name]},
+				Type:              types.String{Value: branch.Type},
+				QualityGateStatus: types.String{Value: branch.Status.QualityGateStatus},
+				AnalysisDate:      types.String{Value: branch.AnalysisDate},
+			}, branch.IsMain, true
+		}
+	}
+	return ProjectBranch{}, false, false
+}
+
+// listProjectBranches lists the branches of the project, and returns whether each branch is excluded from purging by its name
+func listProjectBranches(client *sonarcloud.Client, projectKey string) (*project_branches.ListResponse, map[string]bool, error) {
+	response, err := client.ProjectBranches.List(project_branches.ListRequest{Project: projectKey})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The ListResponse of the project_branches package does not include whether a branch is excluded from purging
+	purgeResponse, err := getWithResponse[ProjectBranchesPurgeResponse](client, "/project_branches/list", "project", projectKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	excludedFromPurge := make(map[string]bool, len(purgeResponse.Branches))
+	for _, branch := range purgeResponse.Branches {
+		excludedFromPurge[branch.Name] = branch.ExcludedFromPurge
+	}
+	return response, excludedFromPurge, nil
+}
+
+// ProjectBranchDeletionProtectionRequest represents a request to protect a branch against automatic deletion.
+type ProjectBranchDeletionProtectionRequest struct {
+	Branch  string `form:"branch,omitempty"`
+	Project string `form:"project,omitempty"`
+	Value   bool   `form:"value"`
+}
+
+// ProjectBranchesPurgeResponse represents the response of listing the branches of a project, with only the fields that
+// are missing from the ListResponse of the project_branches package.
+type ProjectBranchesPurgeResponse struct {
+	Branches []struct {
+		Name              string `json:"name,omitempty"`
+		ExcludedFromPurge bool   `json:"excludedFromPurge,omitempty"`
+	} `json:"branches,omitempty"`
+}
@@ -0,0 +1,70 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccPreCheckProjectBranch(t *testing.T) {
+	t.Helper()
+	for _, variable := range []string{"SONARCLOUD_PROJECT_KEY", "SONARCLOUD_PROJECT_BRANCH"} {
+		if v := os.Getenv(variable); v == "" {
+			t.Fatalf("%s must be set for acceptance tests", variable)
+		}
+	}
+}
+
+func TestAccProjectBranch(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	name := os.Getenv("SONARCLOUD_PROJECT_BRANCH")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckProjectBranch(t)
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectBranchConfig(projectKey, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_branch.test", "project_key", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_branch.test", "name", name),
+					resource.TestCheckResourceAttr("sonarcloud_project_branch.test", "keep_when_inactive", "true"),
+					resource.TestCheckResourceAttrSet("sonarcloud_project_branch.test", "type"),
+				),
+			},
+			{
+				ResourceName:      "sonarcloud_project_branch.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s,%s", projectKey, name),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectBranchConfig(projectKey, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_branch.test", "keep_when_inactive", "false"),
+				),
+			},
+		},
+		CheckDestroy: testAccProjectBranchDestroy,
+	})
+}
+
+func testAccProjectBranchDestroy(_ *terraform.State) error {
+	return nil
+}
+
+func testAccProjectBranchConfig(projectKey, name string, keepWhenInactive bool) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_branch" "test" {
+	project_key        = "%s"
+	name               = "%s"
+	keep_when_inactive = %t
+}
+`, projectKey, name, keepWhenInactive)
+}
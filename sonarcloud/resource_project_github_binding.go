@@ -0,0 +1,81 @@
+package sonarcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceProjectGithubBindingType struct{}
+
+func (r resourceProjectGithubBindingType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return projectBindingSchema("GitHub", map[string]tfsdk.Attribute{
+		"repository": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The slug of the GitHub repository, in the format `owner/repository`.",
+		},
+		"summary_comment_enabled": {
+			Type:        types.BoolType,
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether a summary comment is added to pull requests. Defaults to `true`.",
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.UseStateForUnknown(),
+			},
+		},
+	}), nil
+}
+
+func (r resourceProjectGithubBindingType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectBinding[ProjectGithubBinding]{
+		p:        *(p.(*provider)),
+		alm:      githubBindingAlm{},
+		typeName: "sonarcloud_project_github_binding",
+	}, nil
+}
+
+type githubBindingAlm struct{}
+
+func (a githubBindingAlm) name() string {
+	return "GitHub"
+}
+
+func (a githubBindingAlm) alm() string {
+	return "github"
+}
+
+func (a githubBindingAlm) projectKey(binding ProjectGithubBinding) string {
+	return binding.ProjectKey.Value
+}
+
+func (a githubBindingAlm) set(client *sonarcloud.Client, binding ProjectGithubBinding) error {
+	request := ProjectGithubBindingRequest{
+		Monorepo:              boolOrDefault(binding.Monorepo, false),
+		Project:               binding.ProjectKey.Value,
+		Repository:            binding.Repository.Value,
+		SummaryCommentEnabled: boolOrDefault(binding.SummaryCommentEnabled, true),
+	}
+	return sonarcloud.Post(client, "/alm_settings/set_github_binding", request)
+}
+
+func (a githubBindingAlm) from(projectKey string, response *ProjectBindingResponse) ProjectGithubBinding {
+	return ProjectGithubBinding{
+		ID:                    types.String{Value: projectKey},
+		ProjectKey:            types.String{Value: projectKey},
+		Repository:            types.String{Value: response.Repository},
+		SummaryCommentEnabled: types.Bool{Value: response.SummaryCommentEnabled},
+		Monorepo:              types.Bool{Value: response.Monorepo},
+	}
+}
+
+// ProjectGithubBindingRequest represents a request to bind a project to a GitHub repository.
+type ProjectGithubBindingRequest struct {
+	Monorepo              bool   `form:"monorepo"`
+	Project               string `form:"project,omitempty"`
+	Repository            string `form:"repository,omitempty"`
+	SummaryCommentEnabled bool   `form:"summaryCommentEnabled"`
+}
@@ -0,0 +1,53 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProjectGithubBinding(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	repository := os.Getenv("SONARCLOUD_GITHUB_REPOSITORY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckLiveAPI(t)
+			testAccPreCheckProjectBinding(t, "SONARCLOUD_GITHUB_REPOSITORY")
+		},
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectGithubBindingConfig(projectKey, repository, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "project_key", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "repository", repository),
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "summary_comment_enabled", "true"),
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "monorepo", "false"),
+				),
+			},
+			projectBindingImportCheck("sonarcloud_project_github_binding.test", projectKey),
+			{
+				Config: testAccProjectGithubBindingConfig(projectKey, repository, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_github_binding.test", "summary_comment_enabled", "false"),
+				),
+			},
+			projectBindingImportCheck("sonarcloud_project_github_binding.test", projectKey),
+		},
+		CheckDestroy: testAccProjectBindingDestroy,
+	})
+}
+
+func testAccProjectGithubBindingConfig(projectKey, repository string, summaryCommentEnabled bool) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_github_binding" "test" {
+	project_key             = "%s"
+	repository              = "%s"
+	summary_comment_enabled = %t
+}
+`, projectKey, repository, summaryCommentEnabled)
+}
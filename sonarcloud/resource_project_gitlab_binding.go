@@ -0,0 +1,57 @@
+package sonarcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceProjectGitlabBindingType struct{}
+
+func (r resourceProjectGitlabBindingType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return projectBindingSchema("GitLab", map[string]tfsdk.Attribute{
+		"repository": {
+			Type:        types.StringType,
+			Required:    true,
+			Description: "The ID of the GitLab project.",
+		},
+	}), nil
+}
+
+func (r resourceProjectGitlabBindingType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectBinding[ProjectRepositoryBinding]{
+		p:        *(p.(*provider)),
+		alm:      gitlabBindingAlm{},
+		typeName: "sonarcloud_project_gitlab_binding",
+	}, nil
+}
+
+type gitlabBindingAlm struct{}
+
+func (a gitlabBindingAlm) name() string {
+	return "GitLab"
+}
+
+func (a gitlabBindingAlm) alm() string {
+	return "gitlab"
+}
+
+func (a gitlabBindingAlm) projectKey(binding ProjectRepositoryBinding) string {
+	return binding.ProjectKey.Value
+}
+
+func (a gitlabBindingAlm) set(client *sonarcloud.Client, binding ProjectRepositoryBinding) error {
+	request := ProjectRepositoryBindingRequest{
+		Monorepo:   boolOrDefault(binding.Monorepo, false),
+		Project:    binding.ProjectKey.Value,
+		Repository: binding.Repository.Value,
+	}
+	return sonarcloud.Post(client, "/alm_settings/set_gitlab_binding", request)
+}
+
+func (a gitlabBindingAlm) from(projectKey string, response *ProjectBindingResponse) ProjectRepositoryBinding {
+	return projectRepositoryBindingFrom(projectKey, response)
+}
@@ -61,6 +61,8 @@ type resourceProjectLink struct {
 }
 
 func (r resourceProjectLink) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_link", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -107,6 +109,8 @@ func (r resourceProjectLink) Create(ctx context.Context, req tfsdk.CreateResourc
 }
 
 func (r resourceProjectLink) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_link", "read", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state ProjectLink
 	diags := req.State.Get(ctx, &state)
@@ -143,6 +147,8 @@ func (r resourceProjectLink) Update(_ context.Context, _ tfsdk.UpdateResourceReq
 }
 
 func (r resourceProjectLink) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_link", "delete", req.State)(&resp.Diagnostics)
+
 	var state ProjectLink
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -61,6 +61,8 @@ type resourceProjectMainBranch struct {
 }
 
 func (r resourceProjectMainBranch) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_main_branch", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -103,6 +105,8 @@ func (r resourceProjectMainBranch) Create(ctx context.Context, req tfsdk.CreateR
 }
 
 func (r resourceProjectMainBranch) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_main_branch", "read", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state ProjectMainBranch
 	diags := req.State.Get(ctx, &state)
@@ -135,6 +139,8 @@ func (r resourceProjectMainBranch) Read(ctx context.Context, req tfsdk.ReadResou
 }
 
 func (r resourceProjectMainBranch) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_main_branch", "update", req.Plan)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state ProjectMainBranch
 	diags := req.State.Get(ctx, &state)
@@ -151,7 +157,7 @@ func (r resourceProjectMainBranch) Update(ctx context.Context, req tfsdk.UpdateR
 		return
 	}
 
-	changed := changedAttrs(req, diags)
+	changed := changedAttrs(req, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -191,6 +197,8 @@ func (r resourceProjectMainBranch) Update(ctx context.Context, req tfsdk.UpdateR
 }
 
 func (r resourceProjectMainBranch) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_main_branch", "delete", req.State)(&resp.Diagnostics)
+
 	var state ProjectMainBranch
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,389 @@
+package sonarcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceProjectSettingType struct{}
+
+func (r resourceProjectSettingType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages a setting for the whole organization or a specific project, e.g. `sonar.exclusions`. " +
+			"Exactly one of `value`, `values` or `field_values` must be set. The setting is reset to its default value on destroy.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Description: "The implicit ID of the resource.",
+				Computed:    true,
+			},
+			"project_key": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The key of the project to set the setting for. If omitted, the setting is set for the organization.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"key": {
+				Type:        types.StringType,
+				Required:    true,
+				Description: "The key of the setting, e.g. `sonar.exclusions`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"value": {
+				Type:        types.StringType,
+				Optional:    true,
+				Description: "The value of a single-value setting.",
+			},
+			"values": {
+				Type:        types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "The values of a multi-value setting, e.g. `sonar.exclusions`.",
+			},
+			"field_values": {
+				Type:     types.ListType{ElemType: types.MapType{ElemType: types.StringType}},
+				Optional: true,
+				Description: "The entries of a property set setting, e.g. `sonar.issue.ignore.multicriteria`. " +
+					"Each entry maps the field keys of the property set to their values.",
+			},
+		},
+	}, nil
+}
+
+func (r resourceProjectSettingType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceProjectSetting{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceProjectSetting struct {
+	p provider
+}
+
+func (r resourceProjectSetting) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var config ProjectSetting
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	count := 0
+	for _, set := range []bool{!config.Value.Null, !config.Values.Null, !config.FieldValues.Null} {
+		if set {
+			count++
+		}
+	}
+	if count != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid setting configuration",
+			"Exactly one of `value`, `values` or `field_values` must be set.",
+		)
+	}
+}
+
+func (r resourceProjectSetting) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_setting", "create", req.Plan)(&resp.Diagnostics)
+
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProjectSetting
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.set(ctx, plan, &resp.Diagnostics) {
+		return
+	}
+
+	result, ok := r.find(plan, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Could not find the setting",
+				fmt.Sprintf("The setting '%s' was not found after it was set", plan.Key.Value),
+			)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectSetting) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_setting", "read", req.State)(&resp.Diagnostics)
+
+	var state ProjectSetting
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, ok := r.find(state, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			// The setting was reset outside of Terraform
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectSetting) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_setting", "update", req.Plan)(&resp.Diagnostics)
+
+	var plan ProjectSetting
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.set(ctx, plan, &resp.Diagnostics) {
+		return
+	}
+
+	result, ok := r.find(plan, &resp.Diagnostics)
+	if !ok {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Could not find the setting",
+				fmt.Sprintf("The setting '%s' was not found after it was set", plan.Key.Value),
+			)
+		}
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceProjectSetting) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_project_setting", "delete", req.State)(&resp.Diagnostics)
+
+	var state ProjectSetting
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := SettingResetRequest{
+		Component:    state.ProjectKey.Value,
+		Keys:         state.Key.Value,
+		Organization: r.p.organization,
+	}
+	err := sonarcloud.Post(r.p.client, "/settings/reset", request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not reset the setting",
+			fmt.Sprintf("The Reset request returned an error: %+v", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceProjectSetting) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) < 1 || len(idParts) > 2 || idParts[0] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: key OR key,project_key. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), idParts[0])...)
+	if len(idParts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[1])...)
+	}
+}
+
+// set sends the planned value(s) of the setting to SonarCloud
+func (r resourceProjectSetting) set(ctx context.Context, plan ProjectSetting, diags *diag.Diagnostics) bool {
+	request := SettingSetRequest{
+		Component:    plan.ProjectKey.Value,
+		Key:          plan.Key.Value,
+		Organization: r.p.organization,
+		Value:        plan.Value.Value,
+	}
+
+	if !plan.Values.Null {
+		values := make([]string, len(plan.Values.Elems))
+		diags.Append(plan.Values.ElementsAs(ctx, &values, false)...)
+		request.Values = values
+	}
+
+	if !plan.FieldValues.Null {
+		fieldValues := make([]map[string]string, len(plan.FieldValues.Elems))
+		diags.Append(plan.FieldValues.ElementsAs(ctx, &fieldValues, false)...)
+		for _, fields := range fieldValues {
+			encoded, err := json.Marshal(fields)
+			if err != nil {
+				diags.AddError(
+					"Could not encode the setting field values",
+					fmt.Sprintf("The field values could not be encoded as JSON: %+v", err),
+				)
+				return false
+			}
+			request.FieldValues = append(request.FieldValues, string(encoded))
+		}
+	}
+	if diags.HasError() {
+		return false
+	}
+
+	err := sonarcloud.Post(r.p.client, "/settings/set", request)
+	if err != nil {
+		diags.AddError(
+			"Could not set the setting",
+			fmt.Sprintf("The Set request returned an error: %+v", err),
+		)
+		return false
+	}
+	return true
+}
+
+// find returns the setting as it is currently set on the configured scope.
+// Settings that are not set, or only inherited from a parent scope, are not found.
+func (r resourceProjectSetting) find(setting ProjectSetting, diags *diag.Diagnostics) (ProjectSetting, bool) {
+	params := []string{"keys", setting.Key.Value}
+	if setting.ProjectKey.Value != "" {
+		params = append(params, "component", setting.ProjectKey.Value)
+	}
+
+	response, err := getWithResponse[SettingValuesResponse](r.p.client, "/settings/values", params...)
+	if err != nil {
+		diags.AddError(
+			"Could not read the setting",
+			fmt.Sprintf("The Values request returned an error: %+v", err),
+		)
+		return ProjectSetting{}, false
+	}
+
+	return findProjectSetting(response, setting)
+}
+
+// findProjectSetting returns the setting with the same key, if it is set on the scope of the given setting.
+// Only the attribute that is already in use is populated, unless none is in use, e.g. after an import.
+func findProjectSetting(response *SettingValuesResponse, setting ProjectSetting) (ProjectSetting, bool) {
+	for _, s := range response.Settings {
+		if s.Key != setting.Key.Value || s.Inherited {
+			continue
+		}
+
+		id := setting.Key.Value
+		if setting.ProjectKey.Value != "" {
+			id = fmt.Sprintf("%s,%s", setting.Key.Value, setting.ProjectKey.Value)
+		}
+		result := ProjectSetting{
+			ID:          types.String{Value: id},
+			ProjectKey:  setting.ProjectKey,
+			Key:         setting.Key,
+			Value:       types.String{Null: true},
+			Values:      types.List{ElemType: types.StringType, Null: true},
+			FieldValues: types.List{ElemType: types.MapType{ElemType: types.StringType}, Null: true},
+		}
+
+		useValue, useValues, useFieldValues := projectSettingAttributesInUse(setting, s)
+		switch {
+		case useValue:
+			value := s.Value
+			if value == "" {
+				value = strings.Join(s.Values, ",")
+			}
+			result.Value = types.String{Value: value}
+		case useValues:
+			values := s.Values
+			if len(values) == 0 && s.Value != "" {
+				values = strings.Split(s.Value, ",")
+			}
+			result.Values = types.List{ElemType: types.StringType, Elems: stringAttributes(values)}
+		case useFieldValues:
+			fieldValues := make([]attr.Value, len(s.FieldValues))
+			for i, fields := range s.FieldValues {
+				fieldValues[i] = types.Map{ElemType: types.StringType, Elems: stringMapAttributes(fields)}
+			}
+			result.FieldValues.Elems = fieldValues
+			result.FieldValues.Null = false
+		}
+
+		return result, true
+	}
+	return ProjectSetting{}, false
+}
+
+// projectSettingAttributesInUse returns which of the value attributes is used by the setting.
+// If none is in use yet, it is derived from the response instead.
+func projectSettingAttributesInUse(setting ProjectSetting, s SettingValuesResponseSetting) (useValue, useValues, useFieldValues bool) {
+	useValue = !setting.Value.Null
+	useValues = !setting.Values.Null
+	useFieldValues = !setting.FieldValues.Null
+	if !useValue && !useValues && !useFieldValues {
+		useValues = len(s.Values) > 0
+		useFieldValues = len(s.FieldValues) > 0
+		useValue = !useValues && !useFieldValues
+	}
+	return useValue, useValues, useFieldValues
+}
+
+// SettingSetRequest represents a request to update a setting.
+// Values and FieldValues are sent as repeated form parameters.
+type SettingSetRequest struct {
+	Component    string   `form:"component,omitempty"`
+	FieldValues  []string `form:"fieldValues,omitempty"`
+	Key          string   `form:"key,omitempty"`
+	Organization string   `form:"organization,omitempty"`
+	Value        string   `form:"value,omitempty"`
+	Values       []string `form:"values,omitempty"`
+}
+
+// SettingResetRequest represents a request to reset one or more settings to their default value.
+type SettingResetRequest struct {
+	Component    string `form:"component,omitempty"`
+	Keys         string `form:"keys,omitempty"`
+	Organization string `form:"organization,omitempty"`
+}
+
+// SettingValuesResponse represents the response of listing setting values.
+type SettingValuesResponse struct {
+	Settings []SettingValuesResponseSetting `json:"settings,omitempty"`
+}
+
+// SettingValuesResponseSetting represents a single setting in the setting values response.
+type SettingValuesResponseSetting struct {
+	Inherited   bool                `json:"inherited,omitempty"`
+	Key         string              `json:"key,omitempty"`
+	Value       string              `json:"value,omitempty"`
+	Values      []string            `json:"values,omitempty"`
+	FieldValues []map[string]string `json:"fieldValues,omitempty"`
+}
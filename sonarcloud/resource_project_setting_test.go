@@ -0,0 +1,86 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccProjectSetting(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectSettingValuesConfig(projectKey, []string{"**/vendor/**"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "project_key", projectKey),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "key", "sonar.exclusions"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "values.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "values.0", "**/vendor/**"),
+				),
+			},
+			projectSettingImportCheck("sonarcloud_project_setting.test", "sonar.exclusions", projectKey),
+			{
+				Config: testAccProjectSettingValuesConfig(projectKey, []string{"**/vendor/**", "**/generated/**"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "values.#", "2"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.test", "values.1", "**/generated/**"),
+				),
+			},
+			projectSettingImportCheck("sonarcloud_project_setting.test", "sonar.exclusions", projectKey),
+			{
+				Config: testAccProjectSettingFieldValuesConfig(projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.multicriteria", "field_values.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.multicriteria", "field_values.0.ruleKey", "java:S106"),
+					resource.TestCheckResourceAttr("sonarcloud_project_setting.multicriteria", "field_values.0.resourceKey", "**/*.java"),
+				),
+			},
+		},
+		CheckDestroy: testAccProjectSettingDestroy,
+	})
+}
+
+func testAccProjectSettingDestroy(_ *terraform.State) error {
+	return nil
+}
+
+func testAccProjectSettingValuesConfig(projectKey string, values []string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_setting" "test" {
+	project_key = "%s"
+	key         = "sonar.exclusions"
+	values      = %s
+}
+`, projectKey, terraformListString(values))
+}
+
+func testAccProjectSettingFieldValuesConfig(projectKey string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project_setting" "multicriteria" {
+	project_key  = "%s"
+	key          = "sonar.issue.ignore.multicriteria"
+	field_values = [
+		{
+			ruleKey     = "java:S106"
+			resourceKey = "**/*.java"
+		}
+	]
+}
+`, projectKey)
+}
+
+func projectSettingImportCheck(resourceName, key, projectKey string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     fmt.Sprintf("%s,%s", key, projectKey),
+		ImportStateVerify: true,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
 	"testing"
 )
 
@@ -52,6 +53,56 @@ func TestAccResourceProject(t *testing.T) {
 	})
 }
 
+func TestAccResourceProjectAnalysisScope(t *testing.T) {
+	key := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum) + "sonarcloud-provider-acc-test_scope"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectAnalysisScopeConfig(key, []string{"**/vendor/**"}, []string{"**/*_test.go"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "source_exclusions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_project.test", "source_exclusions.*", "**/vendor/**"),
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "coverage_exclusions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_project.test", "coverage_exclusions.*", "**/*_test.go"),
+					resource.TestCheckNoResourceAttr("sonarcloud_project.test", "test_inclusions"),
+				),
+			},
+			{
+				Config: testAccProjectAnalysisScopeConfig(key, []string{"**/vendor/**", "**/generated/**"}, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "source_exclusions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_project.test", "source_exclusions.*", "**/generated/**"),
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "coverage_exclusions.#", "0"),
+				),
+			},
+			{
+				Config:      testAccProjectTagsConfig(key, []string{"Team-A"}),
+				ExpectError: regexp.MustCompile("Invalid Tag in Set"),
+			},
+			{
+				Config: testAccProjectTagsConfig(key, []string{"team-a", "service"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_project.test", "tags.*", "team-a"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_project.test", "tags.*", "service"),
+				),
+			},
+			projectImportCheck("sonarcloud_project.test", key),
+			{
+				Config: testAccProjectTagsConfig(key, []string{"team-b"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_project.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sonarcloud_project.test", "tags.*", "team-b"),
+				),
+			},
+		},
+		CheckDestroy: testAccProjectDestroy,
+	})
+}
+
 func testAccProjectDestroy(_ *terraform.State) error {
 	return nil
 }
@@ -66,6 +117,33 @@ resource "sonarcloud_project" "test" {
 `, name, key, visibility)
 }
 
+func testAccProjectAnalysisScopeConfig(key string, sourceExclusions, coverageExclusions []string) string {
+	coverage := "[]"
+	if len(coverageExclusions) > 0 {
+		coverage = terraformListString(coverageExclusions)
+	}
+	return fmt.Sprintf(`
+resource "sonarcloud_project" "test" {
+	name = "project_scope"
+	key = "%s"
+	visibility = "public"
+	source_exclusions = %s
+	coverage_exclusions = %s
+}
+`, key, terraformListString(sourceExclusions), coverage)
+}
+
+func testAccProjectTagsConfig(key string, tags []string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_project" "test" {
+	name = "project_scope"
+	key = "%s"
+	visibility = "public"
+	tags = %s
+}
+`, key, terraformListString(tags))
+}
+
 func projectImportCheck(resourceName, key string) resource.TestStep {
 	return resource.TestStep{
 		ResourceName:      resourceName,
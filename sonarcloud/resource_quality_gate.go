@@ -3,16 +3,30 @@ package sonarcloud
 import (
 	"context"
 	"fmt"
+	"strconv"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/metrics"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/qualitygates"
 )
 
 type resourceQualityGateType struct{}
 
+// conditionType is the type of a single condition, which is needed to build the inherited_conditions list
+var conditionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"error":  types.StringType,
+		"id":     types.Float64Type,
+		"metric": types.StringType,
+		"op":     types.StringType,
+	},
+}
+
 func (r resourceQualityGateType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
 		Description: "This resource manages a Quality Gate",
@@ -21,6 +35,9 @@ func (r resourceQualityGateType) GetSchema(_ context.Context) (tfsdk.Schema, dia
 				Type:        types.StringType,
 				Description: "Implicit Terraform ID",
 				Computed:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"gate_id": {
 				Type:        types.Float64Type,
@@ -32,9 +49,19 @@ func (r resourceQualityGateType) GetSchema(_ context.Context) (tfsdk.Schema, dia
 			},
 			"name": {
 				Type:        types.StringType,
-				Description: "Name of the Quality Gate.",
+				Description: "Name of the Quality Gate. The gate is renamed in place, keeping the projects it is selected for.",
 				Required:    true,
 			},
+			"copy_from": {
+				Type: types.StringType,
+				Description: "The name or ID of an existing Quality Gate to copy the conditions from when creating this gate, e.g. `Sonar way`." +
+					" The declared `conditions` are applied on top of the copied conditions." +
+					" **Warning:** forces recreation when changed.",
+				Optional: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
 			"is_built_in": {
 				Type:        types.BoolType,
 				Description: "Defines whether the quality gate is built in.",
@@ -53,8 +80,11 @@ func (r resourceQualityGateType) GetSchema(_ context.Context) (tfsdk.Schema, dia
 				},
 			},
 			"conditions": {
-				Optional:    true,
-				Description: "The conditions of this quality gate. Please query https://sonarcloud.io/api/metrics/search for an up-to-date list of conditions.",
+				Optional: true,
+				Description: "The conditions of this quality gate. Use the `sonarcloud_metrics` data source for an up-to-date list of metrics. " +
+					"The `error` threshold must match the type of the metric, e.g. a rating between 1 (A) and 5 (E), " +
+					"and the `op` must make the condition fail on worse values of the metric." +
+					" The metrics are retrieved from SonarCloud, so these checks run when planning, not during `terraform validate`.",
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
 						Type:        types.Float64Type,
@@ -93,6 +123,32 @@ func (r resourceQualityGateType) GetSchema(_ context.Context) (tfsdk.Schema, dia
 					},
 				}),
 			},
+			"inherited_conditions": {
+				Computed:    true,
+				Description: "The conditions that were copied from the `copy_from` gate and are not declared in `conditions`.",
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Type:        types.Float64Type,
+						Description: "Index/ID of the Condition.",
+						Computed:    true,
+					},
+					"metric": {
+						Type:        types.StringType,
+						Description: "The metric on which the condition is based.",
+						Computed:    true,
+					},
+					"op": {
+						Type:        types.StringType,
+						Description: "Operation on which the metric is evaluated.",
+						Computed:    true,
+					},
+					"error": {
+						Type:        types.StringType,
+						Description: "The value on which the condition errors.",
+						Computed:    true,
+					},
+				}),
+			},
 		},
 	}, nil
 }
@@ -108,6 +164,8 @@ type resourceQualityGate struct {
 }
 
 func (r resourceQualityGate) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_gate", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -125,30 +183,14 @@ func (r resourceQualityGate) Create(ctx context.Context, req tfsdk.CreateResourc
 		return
 	}
 
-	// Fill in api action struct for Quality Gates
-	request := qualitygates.CreateRequest{
-		Name:         plan.Name.Value,
-		Organization: r.p.organization,
-	}
-
-	res, err := r.p.client.Qualitygates.Create(request)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Could not create the Quality Gate",
-			fmt.Sprintf("The Quality Gate create request returned an error: %+v", err),
-		)
+	created, ok := r.createOrCopy(plan, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	var result = QualityGate{
-		ID:     types.String{Value: fmt.Sprintf("%d", int(res.Id))},
-		GateId: types.Float64{Value: res.Id},
-		Name:   types.String{Value: res.Name},
-	}
-
 	if plan.IsDefault.Value {
 		setDefualtRequest := qualitygates.SetAsDefaultRequest{
-			Id:           fmt.Sprintf("%d", int(result.GateId.Value)),
+			Id:           fmt.Sprintf("%d", int(created.GateId.Value)),
 			Organization: r.p.organization,
 		}
 		err := r.p.client.Qualitygates.SetAsDefault(setDefualtRequest)
@@ -160,55 +202,114 @@ func (r resourceQualityGate) Create(ctx context.Context, req tfsdk.CreateResourc
 		}
 	}
 
-	for _, conditionPlan := range plan.Conditions {
-		conditionRequests := qualitygates.CreateConditionRequest{
-			Error:        conditionPlan.Error.Value,
-			GateId:       fmt.Sprintf("%d", int(result.GateId.Value)),
-			Metric:       conditionPlan.Metric.Value,
-			Op:           conditionPlan.Op.Value,
+	// Conditions that were copied from the source gate are updated instead of created
+	toCreate, toUpdate := declaredConditions(plan.Conditions, created.Conditions)
+
+	if len(toUpdate) > 0 && !r.handleConditionUpdates(toUpdate, &resp.Diagnostics) {
+		return
+	}
+
+	if len(toCreate) > 0 && !r.handleConditionCreates(created.GateId, toCreate, &resp.Diagnostics) {
+		return
+	}
+
+	result, ok := r.find(created.GateId.Value, plan.Name.Value, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	result, diags = withInheritedConditions(ctx, result, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// createOrCopy creates an empty quality gate, or copies it from the source gate if copy_from is set
+func (r resourceQualityGate) createOrCopy(plan QualityGate, diags *diag.Diagnostics) (QualityGate, bool) {
+	if plan.CopyFrom.Null || plan.CopyFrom.Value == "" {
+		// Fill in api action struct for Quality Gates
+		request := qualitygates.CreateRequest{
+			Name:         plan.Name.Value,
 			Organization: r.p.organization,
 		}
-		res, err := r.p.client.Qualitygates.CreateCondition(conditionRequests)
+
+		res, err := r.p.client.Qualitygates.Create(request)
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Could not create a Condition",
-				fmt.Sprintf("The Condition Create Request returned an error: %+v", err),
+			diags.AddError(
+				"Could not create the Quality Gate",
+				fmt.Sprintf("The Quality Gate create request returned an error: %+v", err),
 			)
-			return
+			return QualityGate{}, false
 		}
-		// didn't implement warning
-		result.Conditions = append(result.Conditions, Condition{
-			Error:  types.String{Value: res.Error},
-			ID:     types.Float64{Value: res.Id},
-			Metric: types.String{Value: res.Metric},
-			Op:     types.String{Value: res.Op},
-		})
+		return QualityGate{
+			GateId: types.Float64{Value: res.Id},
+			Name:   types.String{Value: res.Name},
+		}, true
 	}
 
-	// Actions are not returned with create request, so we need to query for them
-	listRequest := qualitygates.ListRequest{
+	response, err := r.p.client.Qualitygates.List(qualitygates.ListRequest{Organization: r.p.organization})
+	if err != nil {
+		diags.AddError(
+			"Could not read the Quality Gate",
+			fmt.Sprintf("The List request returned an error: %+v", err),
+		)
+		return QualityGate{}, false
+	}
+
+	source, ok := findQualityGateSource(response, plan.CopyFrom.Value)
+	if !ok {
+		diags.AddError(
+			"Could not find the Quality Gate to copy from",
+			fmt.Sprintf("No Quality Gate with name or ID '%s' was found", plan.CopyFrom.Value),
+		)
+		return QualityGate{}, false
+	}
+
+	request := qualitygates.CopyRequest{
+		Id:           fmt.Sprintf("%d", int(source.GateId.Value)),
+		Name:         plan.Name.Value,
 		Organization: r.p.organization,
 	}
+	if err := r.p.client.Qualitygates.Copy(request); err != nil {
+		diags.AddError(
+			"Could not copy the Quality Gate",
+			fmt.Sprintf("The Copy request returned an error: %+v", err),
+		)
+		return QualityGate{}, false
+	}
+
+	// The copy request does not return the new gate, so it is looked up by its name
+	return r.find(0, plan.Name.Value, diags)
+}
 
-	listRes, err := r.p.client.Qualitygates.List(listRequest)
+// find returns the quality gate with the given ID, or with the given name if the ID is not known yet
+func (r resourceQualityGate) find(gateID float64, name string, diags *diag.Diagnostics) (QualityGate, bool) {
+	response, err := r.p.client.Qualitygates.List(qualitygates.ListRequest{Organization: r.p.organization})
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Could not read the Quality Gate",
 			fmt.Sprintf("The List request returned an error: %+v", err),
 		)
-		return
+		return QualityGate{}, false
 	}
 
-	if createdQualityGate, ok := findQualityGate(listRes, result.Name.Value); ok {
-		result.IsBuiltIn = createdQualityGate.IsBuiltIn
-		result.IsDefault = createdQualityGate.IsDefault
+	result, ok := findQualityGate(response, gateID, name)
+	if !ok {
+		diags.AddError(
+			"Could not find the Quality Gate",
+			fmt.Sprintf("The Quality Gate '%s' was not found", name),
+		)
 	}
-
-	diags = resp.State.Set(ctx, result)
-	resp.Diagnostics.Append(diags...)
+	return result, ok
 }
 
 func (r resourceQualityGate) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_gate", "read", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state QualityGate
 	diags := req.State.Get(ctx, &state)
@@ -232,7 +333,13 @@ func (r resourceQualityGate) Read(ctx context.Context, req tfsdk.ReadResourceReq
 	}
 
 	// Check if the resource exists in the list of retrieved resources
-	if result, ok := findQualityGate(response, state.Name.Value); ok {
+	if result, ok := findQualityGate(response, state.GateId.Value, state.Name.Value); ok {
+		result, diags = withInheritedConditions(ctx, result, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		diags = resp.State.Set(ctx, result)
 		resp.Diagnostics.Append(diags...)
 	} else {
@@ -310,7 +417,7 @@ func (r resourceQualityGate) handleDefaultChange(state, plan QualityGate, resp *
 }
 
 // handleConditionUpdates handles updating existing conditions
-func (r resourceQualityGate) handleConditionUpdates(conditions []Condition, resp *tfsdk.UpdateResourceResponse) bool {
+func (r resourceQualityGate) handleConditionUpdates(conditions []Condition, diags *diag.Diagnostics) bool {
 	for _, c := range conditions {
 		request := qualitygates.UpdateConditionRequest{
 			Error:        c.Error.Value,
@@ -322,7 +429,7 @@ func (r resourceQualityGate) handleConditionUpdates(conditions []Condition, resp
 
 		err := r.p.client.Qualitygates.UpdateCondition(request)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Could not update QualityGate condition",
 				fmt.Sprintf("The UpdateCondition request returned an error %+v", err),
 			)
@@ -333,7 +440,7 @@ func (r resourceQualityGate) handleConditionUpdates(conditions []Condition, resp
 }
 
 // handleConditionCreates handles creating new conditions
-func (r resourceQualityGate) handleConditionCreates(gateID types.Float64, conditions []Condition, resp *tfsdk.UpdateResourceResponse) bool {
+func (r resourceQualityGate) handleConditionCreates(gateID types.Float64, conditions []Condition, diags *diag.Diagnostics) bool {
 	for _, c := range conditions {
 		request := qualitygates.CreateConditionRequest{
 			GateId:       fmt.Sprintf("%d", int(gateID.Value)),
@@ -344,7 +451,7 @@ func (r resourceQualityGate) handleConditionCreates(gateID types.Float64, condit
 		}
 		_, err := r.p.client.Qualitygates.CreateCondition(request)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Could not create QualityGate condition",
 				fmt.Sprintf("The CreateCondition request returned an error %+v", err),
 			)
@@ -355,7 +462,7 @@ func (r resourceQualityGate) handleConditionCreates(gateID types.Float64, condit
 }
 
 // handleConditionDeletes handles deleting conditions
-func (r resourceQualityGate) handleConditionDeletes(conditions []Condition, resp *tfsdk.UpdateResourceResponse) bool {
+func (r resourceQualityGate) handleConditionDeletes(conditions []Condition, diags *diag.Diagnostics) bool {
 	for _, c := range conditions {
 		request := qualitygates.DeleteConditionRequest{
 			Id:           fmt.Sprintf("%d", int(c.ID.Value)),
@@ -363,7 +470,7 @@ func (r resourceQualityGate) handleConditionDeletes(conditions []Condition, resp
 		}
 		err := r.p.client.Qualitygates.DeleteCondition(request)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Could not delete QualityGate condition",
 				fmt.Sprintf("The DeleteCondition request returned an error %+v", err),
 			)
@@ -374,6 +481,8 @@ func (r resourceQualityGate) handleConditionDeletes(conditions []Condition, resp
 }
 
 func (r resourceQualityGate) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_gate", "update", req.Plan)(&resp.Diagnostics)
+
 	// retrieve values from state
 	var state QualityGate
 	diags := req.State.Get(ctx, &state)
@@ -400,15 +509,26 @@ func (r resourceQualityGate) Update(ctx context.Context, req tfsdk.UpdateResourc
 
 	toCreate, toUpdate, toRemove := diffConditions(state.Conditions, plan.Conditions)
 
-	if len(toUpdate) > 0 && !r.handleConditionUpdates(toUpdate, resp) {
+	var inheritedConditions []Condition
+	diags = state.InheritedConditions.ElementsAs(ctx, &inheritedConditions, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Newly declared conditions that were inherited from the source gate already exist
+	toCreate, inherited := declaredConditions(toCreate, inheritedConditions)
+	toUpdate = append(toUpdate, inherited...)
+
+	if len(toUpdate) > 0 && !r.handleConditionUpdates(toUpdate, &resp.Diagnostics) {
 		return
 	}
 
-	if len(toCreate) > 0 && !r.handleConditionCreates(state.GateId, toCreate, resp) {
+	if len(toCreate) > 0 && !r.handleConditionCreates(state.GateId, toCreate, &resp.Diagnostics) {
 		return
 	}
 
-	if len(toRemove) > 0 && !r.handleConditionDeletes(toRemove, resp) {
+	if len(toRemove) > 0 && !r.handleConditionDeletes(toRemove, &resp.Diagnostics) {
 		return
 	}
 
@@ -426,13 +546,28 @@ func (r resourceQualityGate) Update(ctx context.Context, req tfsdk.UpdateResourc
 		return
 	}
 
-	if result, ok := findQualityGate(response, plan.Name.Value); ok {
-		diags = resp.State.Set(ctx, result)
-		resp.Diagnostics.Append(diags...)
+	result, ok := findQualityGate(response, state.GateId.Value, plan.Name.Value)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the Quality Gate",
+			fmt.Sprintf("The Quality Gate with ID %d was not found after it was updated", int(state.GateId.Value)),
+		)
+		return
+	}
+
+	result, diags = withInheritedConditions(ctx, result, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r resourceQualityGate) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_gate", "delete", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state QualityGate
 	diags := req.State.Get(ctx, &state)
@@ -474,7 +609,58 @@ func (r resourceQualityGate) Delete(ctx context.Context, req tfsdk.DeleteResourc
 	resp.State.RemoveResource(ctx)
 }
 
+// ModifyPlan validates the conditions against the metrics that are available in SonarCloud.
+// This cannot be done in ValidateConfig, because the provider is not configured during validation.
+func (r resourceQualityGate) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// Nothing to validate on destroy, or before the provider can reach SonarCloud
+	if req.Plan.Raw.IsNull() || !r.p.configured {
+		return
+	}
+
+	// The configured conditions are validated, so the diagnostics point at the configuration
+	var conditions types.Set
+	diags := req.Config.GetAttribute(ctx, path.Root("conditions"), &conditions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || conditions.Unknown || len(conditions.Elems) == 0 {
+		return
+	}
+
+	catalog, ok := r.metricCatalog(&resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	validateConditions(path.Root("conditions"), conditions, catalog, &resp.Diagnostics)
+}
+
+// metricCatalog returns the metrics that are available in SonarCloud by their key
+func (r resourceQualityGate) metricCatalog(diags *diag.Diagnostics) (map[string]catalogMetric, bool) {
+	response, err := r.p.client.Metrics.SearchAll(metrics.SearchRequest{})
+	if err != nil {
+		diags.AddError(
+			"Could not read the metrics",
+			fmt.Sprintf("The SearchAll request returned an error: %+v", err),
+		)
+		return nil, false
+	}
+
+	catalog := make(map[string]catalogMetric, len(response.Metrics))
+	for _, m := range response.Metrics {
+		catalog[m.Key] = catalogMetric{
+			Key:       m.Key,
+			Type:      m.Type,
+			Direction: int(m.Direction),
+		}
+	}
+	return catalog, true
+}
+
 func (r resourceQualityGate) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// A gate can be imported by its ID or by its name
+	if gateID, err := strconv.ParseFloat(req.ID, 64); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("gate_id"), gateID)...)
+		return
+	}
 	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
@@ -490,17 +676,10 @@ func diffDefault(old, updated QualityGate) bool {
 
 // Check if Quality Gate Conditions are different
 func diffConditions(old, updated []Condition) (create, updateList, remove []Condition) {
-	create = []Condition{}
 	remove = []Condition{}
-	updateList = []Condition{}
 
-	for _, c := range updated {
-		if !containsCondition(old, c) {
-			create = append(create, c)
-		} else {
-			updateList = append(updateList, c)
-		}
-	}
+	// The planned IDs of conditions in a set are unknown, so updated conditions get the ID of the existing condition
+	create, updateList = declaredConditions(updated, old)
 	for _, c := range old {
 		if !containsCondition(updated, c) {
 			remove = append(remove, c)
@@ -519,3 +698,52 @@ func containsCondition(list []Condition, item Condition) bool {
 	}
 	return false
 }
+
+// declaredConditions splits the declared conditions into those that need to be created,
+// and those that already exist on the gate, e.g. because they were copied from another gate.
+// The existing conditions get the ID of the condition on the gate, so they can be updated.
+func declaredConditions(declared, existing []Condition) (create, updateList []Condition) {
+	create = []Condition{}
+	updateList = []Condition{}
+
+	for _, c := range declared {
+		found := false
+		for _, e := range existing {
+			if c.Metric.Equal(e.Metric) {
+				c.ID = e.ID
+				updateList = append(updateList, c)
+				found = true
+				break
+			}
+		}
+		if !found {
+			create = append(create, c)
+		}
+	}
+
+	return create, updateList
+}
+
+// withInheritedConditions moves the conditions of a copied gate that are not declared to the inherited conditions.
+// The conditions of a gate that is not copied are all declared, so changes made outside of Terraform are detected.
+func withInheritedConditions(ctx context.Context, result, declared QualityGate) (QualityGate, diag.Diagnostics) {
+	result.CopyFrom = declared.CopyFrom
+	result.InheritedConditions = types.List{ElemType: conditionType, Null: true}
+	if declared.CopyFrom.Null || declared.CopyFrom.Value == "" {
+		return result, nil
+	}
+
+	conditions := result.Conditions
+	result.Conditions = nil
+	inherited := []Condition{}
+	for _, c := range conditions {
+		if containsCondition(declared.Conditions, c) {
+			result.Conditions = append(result.Conditions, c)
+		} else {
+			inherited = append(inherited, c)
+		}
+	}
+
+	diags := tfsdk.ValueFrom(ctx, inherited, types.ListType{ElemType: conditionType}, &result.InheritedConditions)
+	return result, diags
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/reinoudk/go-sonarcloud/sonarcloud/qualitygates"
@@ -50,6 +51,8 @@ type resourceQualityGateSelection struct {
 }
 
 func (r resourceQualityGateSelection) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_gate_selection", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -114,6 +117,8 @@ func (r resourceQualityGateSelection) Create(ctx context.Context, req tfsdk.Crea
 }
 
 func (r resourceQualityGateSelection) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_gate_selection", "read", req.State)(&resp.Diagnostics)
+
 	var state Selection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -133,7 +138,12 @@ func (r resourceQualityGateSelection) Read(ctx context.Context, req tfsdk.ReadRe
 		)
 		return
 	}
-	if result, ok := findSelection(res, state.ProjectKeys.Elems); ok {
+	// After an import, all projects that are selected on the gate are part of the selection
+	keys := state.ProjectKeys.Elems
+	if state.ProjectKeys.Null {
+		keys = selectedProjectKeys(res)
+	}
+	if result, ok := findSelection(res, keys); ok {
 		result.GateId = types.String{Value: state.GateId.Value}
 		result.ID = types.String{Value: state.GateId.Value}
 		diags = resp.State.Set(ctx, result)
@@ -144,6 +154,8 @@ func (r resourceQualityGateSelection) Read(ctx context.Context, req tfsdk.ReadRe
 }
 
 func (r resourceQualityGateSelection) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_gate_selection", "update", req.Plan)(&resp.Diagnostics)
+
 	var state Selection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -217,6 +229,8 @@ func (r resourceQualityGateSelection) Update(ctx context.Context, req tfsdk.Upda
 }
 
 func (r resourceQualityGateSelection) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_gate_selection", "delete", req.State)(&resp.Diagnostics)
+
 	var state Selection
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -242,6 +256,10 @@ func (r resourceQualityGateSelection) Delete(ctx context.Context, req tfsdk.Dele
 	resp.State.RemoveResource(ctx)
 }
 
+func (r resourceQualityGateSelection) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, path.Root("gate_id"), req, resp)
+}
+
 func diffSelection(state, plan Selection) (sel, rem []attr.Value) {
 	for _, old := range state.ProjectKeys.Elems {
 		// assume that old is a string
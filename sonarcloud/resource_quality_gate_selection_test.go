@@ -32,17 +32,69 @@ func TestAccResourceQualityGateSelection(t *testing.T) {
 					resource.TestCheckResourceAttr("sonarcloud_quality_gate_selection.test", "project_keys.0", projectKey),
 				),
 			},
+			{
+				ResourceName:      "sonarcloud_quality_gate_selection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 		CheckDestroy: testAccQualityGateSelectionDestroy,
 	})
 }
 
+func TestAccResourceQualityGateSelectionRename(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+	name := fmt.Sprintf("tf-acceptance-qg-%d", time.Now().Unix())
+	var gateID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckQualityGateSelection(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQualityGateSelectionNamedConfig(name, projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccQualityGateID("sonarcloud_quality_gate.test", &gateID),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_selection.test", "project_keys.0", projectKey),
+				),
+			},
+			{
+				// Renaming the gate keeps its ID, so the selection is kept as well
+				Config: testAccQualityGateSelectionNamedConfig(name+"-renamed", projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.test", "name", name+"-renamed"),
+					resource.TestCheckResourceAttrPtr("sonarcloud_quality_gate.test", "id", &gateID),
+					resource.TestCheckResourceAttrPtr("sonarcloud_quality_gate_selection.test", "id", &gateID),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate_selection.test", "project_keys.0", projectKey),
+				),
+			},
+		},
+		CheckDestroy: testAccQualityGateSelectionDestroy,
+	})
+}
+
+// testAccQualityGateID stores the ID of the quality gate, to compare it in later steps
+func testAccQualityGateID(resourceName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
 func testAccQualityGateSelectionDestroy(_ *terraform.State) error {
 	return nil
 }
 
 func testAccQualityGateSelectionConfig(projectKey string) string {
 	name := fmt.Sprintf("tf-acceptance-qg-%d", time.Now().Unix())
+	return testAccQualityGateSelectionNamedConfig(name, projectKey)
+}
+
+func testAccQualityGateSelectionNamedConfig(name, projectKey string) string {
 	return fmt.Sprintf(`
 resource "sonarcloud_quality_gate" "test" {
 	name = "%s"
@@ -2,7 +2,9 @@ package sonarcloud
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -21,6 +23,18 @@ func TestAccResourceQualityGate(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccQualityGateConfig(names[0], def[0], "new_coverag", testError[0], Op[0]),
+				ExpectError: regexp.MustCompile("Unknown Metric"),
+			},
+			{
+				Config:      testAccQualityGateConfig(names[0], def[0], metrics[0], "120", Op[0]),
+				ExpectError: regexp.MustCompile("Invalid Condition Threshold"),
+			},
+			{
+				Config:      testAccQualityGateConfig(names[0], def[0], metrics[0], testError[0], "GT"),
+				ExpectError: regexp.MustCompile("Invalid Condition Operator"),
+			},
 			{
 				Config: testAccQualityGateConfig(names[0], def[0], metrics[0], testError[0], Op[0]),
 				Check: resource.ComposeTestCheckFunc(
@@ -41,6 +55,43 @@ func TestAccResourceQualityGate(t *testing.T) {
 				),
 			},
 			qualityGateImportCheck("sonarcloud_quality_gate.test", names[1]),
+			{
+				// A gate can also be imported by its ID
+				ResourceName:      "sonarcloud_quality_gate.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccQualityGateDestroy,
+	})
+}
+
+func TestAccResourceQualityGateCopyFrom(t *testing.T) {
+	name := fmt.Sprintf("tf-acceptance-qg-copy-%d", time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQualityGateCopyFromConfig(name, "Sonar way", "90"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.copy", "name", name),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.copy", "copy_from", "Sonar way"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.copy", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.copy", "conditions.0.metric", "new_coverage"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.copy", "conditions.0.error", "90"),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarcloud_quality_gate.copy", "inherited_conditions.*", map[string]string{
+						"metric": "new_duplicated_lines_density",
+					}),
+				),
+			},
+			{
+				Config: testAccQualityGateCopyFromConfig(name, "Sonar way", "95"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_gate.copy", "conditions.0.error", "95"),
+				),
+			},
 		},
 		CheckDestroy: testAccQualityGateDestroy,
 	})
@@ -66,6 +117,22 @@ resource "sonarcloud_quality_gate" "test" {
 	`, name, def, metric, err, op)
 }
 
+func testAccQualityGateCopyFromConfig(name, copyFrom, coverage string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_gate" "copy" {
+	name      = "%s"
+	copy_from = "%s"
+	conditions = [
+		{
+			metric = "new_coverage"
+			error  = "%s"
+			op     = "LT"
+		}
+	]
+}
+`, name, copyFrom, coverage)
+}
+
 func qualityGateImportCheck(resourceName, name string) resource.TestStep {
 	return resource.TestStep{
 		ResourceName:      resourceName,
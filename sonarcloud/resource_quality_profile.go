@@ -0,0 +1,713 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud/paging"
+)
+
+type resourceQualityProfileType struct{}
+
+func (r resourceQualityProfileType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource manages a Quality Profile for a language.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Description: "Implicit Terraform ID, equal to the key of the Quality Profile.",
+				Computed:    true,
+			},
+			"key": {
+				Type:        types.StringType,
+				Description: "Key computed by SonarCloud servers.",
+				Computed:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:        types.StringType,
+				Description: "Name of the Quality Profile.",
+				Required:    true,
+				Validators: []tfsdk.AttributeValidator{
+					stringLengthBetween(1, 100),
+				},
+			},
+			"language": {
+				Type:        types.StringType,
+				Description: "The key of the language of the Quality Profile, e.g. `java`, `js` or `py`. **Warning:** forces recreation when changed.",
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"copy_from": {
+				Type: types.StringType,
+				Description: "The key of an existing Quality Profile of the same language to copy the rules from when creating this profile." +
+					" **Warning:** forces recreation when changed.",
+				Optional: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"is_built_in": {
+				Type:        types.BoolType,
+				Description: "Defines whether the Quality Profile is built in.",
+				Computed:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"is_default": {
+				Type: types.BoolType,
+				Description: "Defines whether the Quality Profile is the default profile for its language in the organization." +
+					" **WARNING**: each language always has a default profile. When this profile stops being the default, or is destroyed," +
+					" the built-in profile of the language becomes the default again.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"rules": {
+				Optional: true,
+				Description: "The rules that are activated in this Quality Profile. If set, this set is authoritative:" +
+					" rules that are active in the profile but not listed here (e.g. copied rules) are deactivated." +
+					" If omitted, the rule activations are not managed by Terraform.",
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"rule": {
+						Type:        types.StringType,
+						Description: "The key of the rule to activate, e.g. `java:S1481`.",
+						Required:    true,
+					},
+					"severity": {
+						Type:        types.StringType,
+						Description: "The severity of the rule in this profile. Must be one of: INFO, MINOR, MAJOR, CRITICAL, BLOCKER. Defaults to the severity of the rule.",
+						Optional:    true,
+						Validators: []tfsdk.AttributeValidator{
+							allowedOptions("INFO", "MINOR", "MAJOR", "CRITICAL", "BLOCKER"),
+						},
+					},
+					"params": {
+						Type:        types.MapType{ElemType: types.StringType},
+						Description: "Overrides for the parameters of the rule. Parameters that are not set use their default value.",
+						Optional:    true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (r resourceQualityProfileType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceQualityProfile{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceQualityProfile struct {
+	p provider
+}
+
+func (r resourceQualityProfile) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_profile", "create", req.Plan)(&resp.Diagnostics)
+
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan QualityProfile
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var key string
+	if plan.CopyFrom.Null || plan.CopyFrom.Value == "" {
+		request := QualityProfileCreateRequest{
+			Language:     plan.Language.Value,
+			Name:         plan.Name.Value,
+			Organization: r.p.organization,
+		}
+
+		res, err := sonarcloud.PostWithResponse[QualityProfileCreateRequest, QualityProfileCreateResponse](r.p.client, "/qualityprofiles/create", request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not create the Quality Profile",
+				fmt.Sprintf("The Create request returned an error: %+v", err),
+			)
+			return
+		}
+		key = res.Profile.Key
+	} else {
+		request := QualityProfileCopyRequest{
+			FromKey: plan.CopyFrom.Value,
+			ToName:  plan.Name.Value,
+		}
+
+		res, err := sonarcloud.PostWithResponse[QualityProfileCopyRequest, QualityProfilesSearchResponseProfile](r.p.client, "/qualityprofiles/copy", request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not copy the Quality Profile",
+				fmt.Sprintf("The Copy request returned an error: %+v", err),
+			)
+			return
+		}
+		key = res.Key
+	}
+
+	if plan.IsDefault.Value && !r.setDefault(plan.Language.Value, plan.Name.Value, &resp.Diagnostics) {
+		return
+	}
+
+	// Not all attributes are returned with the create request, so we need to query for them
+	response, err := getWithResponse[QualityProfilesSearchResponse](r.p.client, "/qualityprofiles/search", "language", plan.Language.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the Quality Profile",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result, ok := findQualityProfile(response, key, plan.Name.Value)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the Quality Profile",
+			fmt.Sprintf("The created Quality Profile with key '%s' was not found in the Search response", key),
+		)
+		return
+	}
+	result.CopyFrom = plan.CopyFrom
+
+	if plan.Rules != nil {
+		rules, ok := r.handleRules(result.Key.Value, plan.Rules, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		result.Rules = rules
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceQualityProfile) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_profile", "read", req.State)(&resp.Diagnostics)
+
+	// Retrieve values from state
+	var state QualityProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := getWithResponse[QualityProfilesSearchResponse](r.p.client, "/qualityprofiles/search", "language", state.Language.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the Quality Profile(s)",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	// Check if the resource exists in the list of retrieved resources
+	if result, ok := findQualityProfile(response, state.Key.Value, state.Name.Value); ok {
+		result.CopyFrom = state.CopyFrom
+
+		if state.Rules != nil {
+			activeRules, err := findActiveRules(r.p.client, result.Key.Value)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Could not read the active rules of the Quality Profile",
+					fmt.Sprintf("The rules Search request returned an error: %+v", err),
+				)
+				return
+			}
+			result.Rules = qualityProfileRulesFrom(activeRules, state.Rules)
+		}
+
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	} else {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r resourceQualityProfile) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_profile", "update", req.Plan)(&resp.Diagnostics)
+
+	// Retrieve values from state
+	var state QualityProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan QualityProfile
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := changedAttrs(req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, ok := changed["name"]; ok {
+		request := QualityProfileRenameRequest{
+			Key:  state.Key.Value,
+			Name: plan.Name.Value,
+		}
+
+		err := sonarcloud.Post(r.p.client, "/qualityprofiles/rename", request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not rename the Quality Profile",
+				fmt.Sprintf("The Rename request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+
+	if !state.IsDefault.Equal(plan.IsDefault) {
+		if plan.IsDefault.Value && !r.setDefault(plan.Language.Value, plan.Name.Value, &resp.Diagnostics) {
+			return
+		}
+		if !plan.IsDefault.Value && !r.resetDefault(plan.Language.Value, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	// We don't have a return value, so we have to query it again
+	response, err := getWithResponse[QualityProfilesSearchResponse](r.p.client, "/qualityprofiles/search", "language", state.Language.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not read the Quality Profile",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+		)
+		return
+	}
+
+	result, ok := findQualityProfile(response, state.Key.Value, plan.Name.Value)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Could not find the Quality Profile",
+			fmt.Sprintf("The Quality Profile '%s' was not found after it was updated", plan.Name.Value),
+		)
+		return
+	}
+	result.CopyFrom = plan.CopyFrom
+
+	if plan.Rules != nil {
+		rules, ok := r.handleRules(result.Key.Value, plan.Rules, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		result.Rules = rules
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceQualityProfile) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_profile", "delete", req.State)(&resp.Diagnostics)
+
+	// Retrieve values from state
+	var state QualityProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The default profile cannot be deleted, so the built-in profile has to become the default first
+	if state.IsDefault.Value && !r.resetDefault(state.Language.Value, &resp.Diagnostics) {
+		return
+	}
+
+	request := QualityProfileDeleteRequest{
+		Language:       state.Language.Value,
+		Organization:   r.p.organization,
+		QualityProfile: state.Name.Value,
+	}
+
+	err := sonarcloud.Post(r.p.client, "/qualityprofiles/delete", request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Could not delete the Quality Profile",
+			fmt.Sprintf("The Delete request returned an error: %+v", err),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ModifyPlan warns when the plan causes the built-in profile to become the default profile of a language again.
+func (r resourceQualityProfile) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	// Nothing to warn about on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state QualityProfile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !state.IsDefault.Value {
+		return
+	}
+
+	if !req.Plan.Raw.IsNull() {
+		var plan QualityProfile
+		diags = req.Plan.Get(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || plan.IsDefault.Unknown || plan.IsDefault.Value {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Default Quality Profile will be reset",
+		fmt.Sprintf("The Quality Profile '%s' is the default profile for language '%s'. "+
+			"After this change, the built-in profile of the language becomes the default profile instead.",
+			state.Name.Value, state.Language.Value),
+	)
+}
+
+func (r resourceQualityProfile) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.SplitN(req.ID, ",", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: language,name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("language"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+}
+
+// setDefault makes the quality profile with the given name the default profile for the language
+func (r resourceQualityProfile) setDefault(language, name string, diags *diag.Diagnostics) bool {
+	request := QualityProfileSetDefaultRequest{
+		Language:       language,
+		Organization:   r.p.organization,
+		QualityProfile: name,
+	}
+
+	err := sonarcloud.Post(r.p.client, "/qualityprofiles/set_default", request)
+	if err != nil {
+		diags.AddError(
+			"Could not set the Quality Profile as default",
+			fmt.Sprintf("The SetDefault request returned an error: %+v", err),
+		)
+		return false
+	}
+	return true
+}
+
+// resetDefault makes the built-in quality profile the default profile for the language again
+func (r resourceQualityProfile) resetDefault(language string, diags *diag.Diagnostics) bool {
+	response, err := getWithResponse[QualityProfilesSearchResponse](r.p.client, "/qualityprofiles/search", "language", language)
+	if err != nil {
+		diags.AddError(
+			"Could not read the Quality Profiles",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+		)
+		return false
+	}
+
+	builtIn, ok := findBuiltInQualityProfile(response)
+	if !ok {
+		diags.AddError(
+			"Could not reset the default Quality Profile",
+			fmt.Sprintf("No built-in Quality Profile was found for language '%s'", language),
+		)
+		return false
+	}
+
+	return r.setDefault(language, builtIn.Name.Value, diags)
+}
+
+// handleRules activates and deactivates rules until the active rules of the profile match the wanted rules.
+// It returns the rule activations as found in the profile afterwards.
+func (r resourceQualityProfile) handleRules(profileKey string, wants []QualityProfileRule, diags *diag.Diagnostics) ([]QualityProfileRule, bool) {
+	activeRules, err := findActiveRules(r.p.client, profileKey)
+	if err != nil {
+		diags.AddError(
+			"Could not read the active rules of the Quality Profile",
+			fmt.Sprintf("The rules Search request returned an error: %+v", err),
+		)
+		return nil, false
+	}
+
+	toActivate, toDeactivate := diffQualityProfileRules(qualityProfileRulesFrom(activeRules, wants), wants)
+
+	for _, rule := range toDeactivate {
+		request := QualityProfileDeactivateRuleRequest{
+			Key:  profileKey,
+			Rule: rule.Rule.Value,
+		}
+		if err := sonarcloud.Post(r.p.client, "/qualityprofiles/deactivate_rule", request); err != nil {
+			diags.AddError(
+				"Could not deactivate the rule",
+				fmt.Sprintf("The DeactivateRule request returned an error: %+v", err),
+			)
+			return nil, false
+		}
+	}
+
+	for _, rule := range toActivate {
+		request := QualityProfileActivateRuleRequest{
+			Key:      profileKey,
+			Params:   ruleParamsString(rule.Params),
+			Rule:     rule.Rule.Value,
+			Severity: rule.Severity.Value,
+		}
+		if err := sonarcloud.Post(r.p.client, "/qualityprofiles/activate_rule", request); err != nil {
+			diags.AddError(
+				"Could not activate the rule",
+				fmt.Sprintf("The ActivateRule request returned an error: %+v", err),
+			)
+			return nil, false
+		}
+	}
+
+	backoffConfig := defaultBackoffConfig()
+
+	rules, err := backoff.RetryWithData(
+		func() ([]QualityProfileRule, error) {
+			return findQualityProfileRulesSet(r.p.client, profileKey, wants)
+		}, backoffConfig)
+	if err != nil {
+		diags.AddError(
+			"Could not find the planned rule activations",
+			fmt.Sprintf("The findQualityProfileRulesSet call returned an error: %+v ", err),
+		)
+		return nil, false
+	}
+
+	return rules, true
+}
+
+// findActiveRules returns the activations of all rules that are active in the quality profile with the given key, indexed by rule key
+func findActiveRules(client *sonarcloud.Client, profileKey string) (map[string]QualityProfileActiveRule, error) {
+	result := make(map[string]QualityProfileActiveRule)
+	pageSize := 500
+	for page := 1; ; page++ {
+		res, err := getWithResponse[QualityProfileActiveRulesResponse](client, "/rules/search",
+			"qprofile", profileKey,
+			"activation", "true",
+			"f", "actives",
+			"p", strconv.Itoa(page),
+			"ps", strconv.Itoa(pageSize),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for ruleKey, activations := range res.Actives {
+			for _, activation := range activations {
+				if activation.QProfile == profileKey {
+					result[ruleKey] = activation
+				}
+			}
+		}
+
+		pager := paging.Paging{PageIndex: page, PageSize: pageSize, Total: int(res.Total)}
+		if pager.End() {
+			break
+		}
+	}
+	return result, nil
+}
+
+// findQualityProfileRulesSet tries to find the expected rule activations in the quality profile with the given key
+func findQualityProfileRulesSet(client *sonarcloud.Client, profileKey string, expected []QualityProfileRule) ([]QualityProfileRule, error) {
+	activeRules, err := findActiveRules(client, profileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	found := qualityProfileRulesFrom(activeRules, expected)
+	toActivate, toDeactivate := diffQualityProfileRules(found, expected)
+	if len(toActivate) > 0 || len(toDeactivate) > 0 {
+		return nil, fmt.Errorf("the active rules do not match the expected rules (profileKey='%s', expected='%v', got='%v')",
+			profileKey,
+			expected,
+			found)
+	}
+
+	return found, nil
+}
+
+// qualityProfileRulesFrom converts the active rules into rule activations.
+// Only the severity and parameters that are managed in the given activations are kept, all other values are left out.
+func qualityProfileRulesFrom(activeRules map[string]QualityProfileActiveRule, managed []QualityProfileRule) []QualityProfileRule {
+	ruleKeys := make([]string, 0, len(activeRules))
+	for ruleKey := range activeRules {
+		ruleKeys = append(ruleKeys, ruleKey)
+	}
+	sort.Strings(ruleKeys)
+
+	result := make([]QualityProfileRule, 0, len(ruleKeys))
+	for _, ruleKey := range ruleKeys {
+		activeRule := activeRules[ruleKey]
+		rule := QualityProfileRule{
+			Rule:     types.String{Value: ruleKey},
+			Severity: types.String{Value: activeRule.Severity},
+			Params:   types.Map{ElemType: types.StringType, Null: true},
+		}
+
+		for _, m := range managed {
+			if m.Rule.Value != ruleKey {
+				continue
+			}
+			if m.Severity.Null {
+				rule.Severity = types.String{Null: true}
+			}
+			if !m.Params.Null {
+				params := make(map[string]attr.Value)
+				for _, param := range activeRule.Params {
+					if _, ok := m.Params.Elems[param.Key]; ok {
+						params[param.Key] = types.String{Value: param.Value}
+					}
+				}
+				rule.Params = types.Map{ElemType: types.StringType, Elems: params}
+			}
+			break
+		}
+
+		result = append(result, rule)
+	}
+	return result
+}
+
+// ruleParamsString returns the parameters in the format expected by the API, e.g. "key1=value1;key2=value2"
+func ruleParamsString(params types.Map) string {
+	keys := make([]string, 0, len(params.Elems))
+	for key := range params.Elems {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = fmt.Sprintf("%s=%s", key, params.Elems[key].(types.String).Value)
+	}
+	return strings.Join(pairs, ";")
+}
+
+// QualityProfilesSearchResponse represents the response of the quality profiles search endpoint.
+type QualityProfilesSearchResponse struct {
+	Profiles []QualityProfilesSearchResponseProfile `json:"profiles,omitempty"`
+}
+
+// QualityProfilesSearchResponseProfile represents a single profile in the quality profiles search response.
+type QualityProfilesSearchResponseProfile struct {
+	Key             string  `json:"key,omitempty"`
+	Name            string  `json:"name,omitempty"`
+	Language        string  `json:"language,omitempty"`
+	LanguageName    string  `json:"languageName,omitempty"`
+	IsBuiltIn       bool    `json:"isBuiltIn,omitempty"`
+	IsDefault       bool    `json:"isDefault,omitempty"`
+	IsInherited     bool    `json:"isInherited,omitempty"`
+	ParentKey       string  `json:"parentKey,omitempty"`
+	ActiveRuleCount float64 `json:"activeRuleCount,omitempty"`
+}
+
+// QualityProfileCreateRequest represents a request to create a quality profile.
+type QualityProfileCreateRequest struct {
+	Language     string `form:"language,omitempty"`
+	Name         string `form:"name,omitempty"`
+	Organization string `form:"organization,omitempty"`
+}
+
+// QualityProfileCreateResponse represents the response of the quality profile create endpoint.
+type QualityProfileCreateResponse struct {
+	Profile QualityProfilesSearchResponseProfile `json:"profile,omitempty"`
+}
+
+// QualityProfileCopyRequest represents a request to copy a quality profile.
+type QualityProfileCopyRequest struct {
+	FromKey string `form:"fromKey,omitempty"`
+	ToName  string `form:"toName,omitempty"`
+}
+
+// QualityProfileRenameRequest represents a request to rename a quality profile.
+type QualityProfileRenameRequest struct {
+	Key  string `form:"key,omitempty"`
+	Name string `form:"name,omitempty"`
+}
+
+// QualityProfileDeleteRequest represents a request to delete a quality profile.
+type QualityProfileDeleteRequest struct {
+	Language       string `form:"language,omitempty"`
+	Organization   string `form:"organization,omitempty"`
+	QualityProfile string `form:"qualityProfile,omitempty"`
+}
+
+// QualityProfileActivateRuleRequest represents a request to activate a rule in a quality profile.
+type QualityProfileActivateRuleRequest struct {
+	Key      string `form:"key,omitempty"`
+	Params   string `form:"params,omitempty"`
+	Rule     string `form:"rule,omitempty"`
+	Severity string `form:"severity,omitempty"`
+}
+
+// QualityProfileDeactivateRuleRequest represents a request to deactivate a rule in a quality profile.
+type QualityProfileDeactivateRuleRequest struct {
+	Key  string `form:"key,omitempty"`
+	Rule string `form:"rule,omitempty"`
+}
+
+// QualityProfileActiveRulesResponse represents the response of the rules search endpoint when searching for active rules.
+// The client's rules.SearchResponse cannot be used, as it does not support arbitrary rule keys in 'actives'.
+type QualityProfileActiveRulesResponse struct {
+	Actives map[string][]QualityProfileActiveRule `json:"actives,omitempty"`
+	Total   float64                               `json:"total,omitempty"`
+}
+
+// QualityProfileActiveRule represents the activation of a rule in a quality profile in the rules search response.
+type QualityProfileActiveRule struct {
+	QProfile string `json:"qProfile,omitempty"`
+	Inherit  string `json:"inherit,omitempty"`
+	Severity string `json:"severity,omitempty"`
+	Params   []struct {
+		Key   string `json:"key,omitempty"`
+		Value string `json:"value,omitempty"`
+	} `json:"params,omitempty"`
+}
+
+// QualityProfileSetDefaultRequest represents a request to set a quality profile as the default for its language.
+type QualityProfileSetDefaultRequest struct {
+	Language       string `form:"language,omitempty"`
+	Organization   string `form:"organization,omitempty"`
+	QualityProfile string `form:"qualityProfile,omitempty"`
+}
@@ -0,0 +1,350 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/reinoudk/go-sonarcloud/sonarcloud"
+)
+
+type resourceQualityProfileSelectionType struct{}
+
+func (r resourceQualityProfileSelectionType) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "This resource selects a quality profile for one or more projects. " +
+			"When a project is removed from the selection, it falls back to the default quality profile of the language.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:        types.StringType,
+				Description: "The implicit ID of the resource, equal to the key of the quality profile.",
+				Computed:    true,
+			},
+			"profile_key": {
+				Type:        types.StringType,
+				Description: "The key of the quality profile that is selected for the project(s).",
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"language": {
+				Type:        types.StringType,
+				Description: "The key of the language of the quality profile, e.g. `java`, `js` or `py`.",
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"project_keys": {
+				Type:        types.SetType{ElemType: types.StringType},
+				Description: "The keys of the projects which have been selected on the referenced quality profile.",
+				Required:    true,
+			},
+		},
+	}, nil
+}
+
+func (r resourceQualityProfileSelectionType) NewResource(_ context.Context, p tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return resourceQualityProfileSelection{
+		p: *(p.(*provider)),
+	}, nil
+}
+
+type resourceQualityProfileSelection struct {
+	p provider
+}
+
+func (r resourceQualityProfileSelection) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_profile_selection", "create", req.Plan)(&resp.Diagnostics)
+
+	if !r.p.configured {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider hasn't been configured before apply, likely because it depends on an unknown value from another resource. "+
+				"This leads to weird stuff happening, so we'd prefer if you didn't do that. Thanks!",
+		)
+		return
+	}
+
+	// Retrieve values from plan
+	var plan QualityProfileSelection
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileName, ok := r.profileName(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	for _, s := range plan.ProjectKeys.Elems {
+		request := QualityProfileProjectRequest{
+			Language:       plan.Language.Value,
+			Organization:   r.p.organization,
+			Project:        s.(types.String).Value,
+			QualityProfile: profileName,
+		}
+		err := sonarcloud.Post(r.p.client, "/qualityprofiles/add_project", request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not create Quality Profile Selection",
+				fmt.Sprintf("The AddProject request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+
+	result, ok := r.findSelection(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if !result.ProjectKeys.Equal(plan.ProjectKeys) {
+		resp.Diagnostics.AddError(
+			"Could not find Quality Profile Selection",
+			fmt.Sprintf("Unable to find the project keys: %+v in the selected projects: %+v", plan.ProjectKeys.Elems, result.ProjectKeys.Elems),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceQualityProfileSelection) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_profile_selection", "read", req.State)(&resp.Diagnostics)
+
+	var state QualityProfileSelection
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, ok := r.findProfile(state, &resp.Diagnostics); !ok {
+		if !resp.Diagnostics.HasError() {
+			// The profile was deleted outside of Terraform
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	// Projects that are no longer selected are left out, so they will be selected again on the next apply
+	if result, ok := r.findSelection(state, &resp.Diagnostics); ok {
+		diags = resp.State.Set(ctx, result)
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (r resourceQualityProfileSelection) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_profile_selection", "update", req.Plan)(&resp.Diagnostics)
+
+	var state QualityProfileSelection
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan QualityProfileSelection
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileName, ok := r.profileName(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	toAdd, toRemove := diffAttrSets(state.ProjectKeys, plan.ProjectKeys)
+
+	for _, s := range toRemove {
+		request := QualityProfileProjectRequest{
+			Language:       state.Language.Value,
+			Organization:   r.p.organization,
+			Project:        s.(types.String).Value,
+			QualityProfile: profileName,
+		}
+		err := sonarcloud.Post(r.p.client, "/qualityprofiles/remove_project", request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not remove the project from the Quality Profile selection",
+				fmt.Sprintf("The RemoveProject request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+	for _, s := range toAdd {
+		request := QualityProfileProjectRequest{
+			Language:       plan.Language.Value,
+			Organization:   r.p.organization,
+			Project:        s.(types.String).Value,
+			QualityProfile: profileName,
+		}
+		err := sonarcloud.Post(r.p.client, "/qualityprofiles/add_project", request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not add the project to the Quality Profile selection",
+				fmt.Sprintf("The AddProject request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+
+	result, ok := r.findSelection(plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if !result.ProjectKeys.Equal(plan.ProjectKeys) {
+		resp.Diagnostics.AddError(
+			"Could not find Quality Profile Selection",
+			fmt.Sprintf("Unable to find the project keys: %+v in the selected projects: %+v", plan.ProjectKeys.Elems, result.ProjectKeys.Elems),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r resourceQualityProfileSelection) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_quality_profile_selection", "delete", req.State)(&resp.Diagnostics)
+
+	var state QualityProfileSelection
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileName, ok := r.profileName(state, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	for _, s := range state.ProjectKeys.Elems {
+		request := QualityProfileProjectRequest{
+			Language:       state.Language.Value,
+			Organization:   r.p.organization,
+			Project:        s.(types.String).Value,
+			QualityProfile: profileName,
+		}
+		err := sonarcloud.Post(r.p.client, "/qualityprofiles/remove_project", request)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Could not remove the project from the Quality Profile selection",
+				fmt.Sprintf("The RemoveProject request returned an error: %+v", err),
+			)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r resourceQualityProfileSelection) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: profile_key,language. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("profile_key"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("language"), idParts[1])...)
+}
+
+// profileName looks up the name of the selected profile, which is needed to (de)select projects
+func (r resourceQualityProfileSelection) profileName(selection QualityProfileSelection, diags *diag.Diagnostics) (string, bool) {
+	profile, ok := r.findProfile(selection, diags)
+	if !ok {
+		if !diags.HasError() {
+			diags.AddError(
+				"Could not find the Quality Profile",
+				fmt.Sprintf("No Quality Profile with key '%s' exists for language '%s'", selection.ProfileKey.Value, selection.Language.Value),
+			)
+		}
+		return "", false
+	}
+	return profile.Name.Value, true
+}
+
+// findProfile returns the profile of the selection, if it exists
+func (r resourceQualityProfileSelection) findProfile(selection QualityProfileSelection, diags *diag.Diagnostics) (QualityProfile, bool) {
+	response, err := getWithResponse[QualityProfilesSearchResponse](r.p.client, "/qualityprofiles/search", "language", selection.Language.Value)
+	if err != nil {
+		diags.AddError(
+			"Could not read the Quality Profile",
+			fmt.Sprintf("The Search request returned an error: %+v", err),
+		)
+		return QualityProfile{}, false
+	}
+
+	return findQualityProfile(response, selection.ProfileKey.Value, "")
+}
+
+// findSelection returns the selection with only the project keys that are currently selected on the profile
+func (r resourceQualityProfileSelection) findSelection(selection QualityProfileSelection, diags *diag.Diagnostics) (QualityProfileSelection, bool) {
+	request := QualityProfileProjectsRequest{
+		Key:      selection.ProfileKey.Value,
+		Selected: "selected",
+	}
+	projects, err := sonarcloud.GetAll[QualityProfileProjectsRequest, QualityProfileProjectsResponseProject](r.p.client, "/qualityprofiles/projects", request, "results")
+	if err != nil {
+		diags.AddError(
+			"Could not read the Quality Profile Selection",
+			fmt.Sprintf("The Projects request returned an error: %+v", err),
+		)
+		return QualityProfileSelection{}, false
+	}
+
+	// After an import, all projects that are selected on the profile are part of the selection
+	keys := selection.ProjectKeys.Elems
+	if selection.ProjectKeys.Null {
+		keys = make([]attr.Value, 0, len(projects))
+		for _, p := range projects {
+			keys = append(keys, types.String{Value: p.Key})
+		}
+	}
+
+	return QualityProfileSelection{
+		ID:          types.String{Value: selection.ProfileKey.Value},
+		ProfileKey:  selection.ProfileKey,
+		Language:    selection.Language,
+		ProjectKeys: findQualityProfileSelection(projects, keys),
+	}, true
+}
+
+// QualityProfileProjectRequest represents a request to add a project to or remove a project from a quality profile.
+type QualityProfileProjectRequest struct {
+	Language       string `form:"language,omitempty"`
+	Organization   string `form:"organization,omitempty"`
+	Project        string `form:"project,omitempty"`
+	QualityProfile string `form:"qualityProfile,omitempty"`
+}
+
+// QualityProfileProjectsRequest represents a request to list the projects associated with a quality profile.
+type QualityProfileProjectsRequest struct {
+	Key      string
+	Selected string
+}
+
+// QualityProfileProjectsResponseProject represents a project in the quality profile projects response.
+type QualityProfileProjectsResponseProject struct {
+	Key      string `json:"key,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Selected bool   `json:"selected,omitempty"`
+}
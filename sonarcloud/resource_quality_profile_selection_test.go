@@ -0,0 +1,64 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceQualityProfileSelection(t *testing.T) {
+	projectKey := os.Getenv("SONARCLOUD_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckQualityGateSelection(t); testAccPreCheckLiveAPI(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQualityProfileSelectionConfig(projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					// compare the profile_key between the created quality profile resource and the selection resource
+					resource.TestCheckResourceAttrPair("sonarcloud_quality_profile_selection.test", "profile_key", "sonarcloud_quality_profile.test", "key"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile_selection.test", "language", "java"),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile_selection.test", "project_keys.0", projectKey),
+				),
+			},
+			{
+				ResourceName: "sonarcloud_quality_profile_selection.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["sonarcloud_quality_profile_selection.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: sonarcloud_quality_profile_selection.test")
+					}
+					return fmt.Sprintf("%s,%s", rs.Primary.Attributes["profile_key"], rs.Primary.Attributes["language"]), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: testAccQualityProfileSelectionDestroy,
+	})
+}
+
+func testAccQualityProfileSelectionDestroy(_ *terraform.State) error {
+	return nil
+}
+
+func testAccQualityProfileSelectionConfig(projectKey string) string {
+	name := fmt.Sprintf("tf-acceptance-qp-%d", time.Now().Unix())
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_profile" "test" {
+	name = "%s"
+	language = "java"
+}
+
+resource "sonarcloud_quality_profile_selection" "test" {
+	profile_key = sonarcloud_quality_profile.test.key
+	language = sonarcloud_quality_profile.test.language
+	project_keys = ["%s"]
+}
+	`, name, projectKey)
+}
@@ -0,0 +1,131 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceQualityProfile(t *testing.T) {
+	prefix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	names := []string{prefix + "_quality_profile_a", prefix + "_quality_profile_b"}
+	language := "java"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t); testAccPreCheckLiveAPI(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQualityProfileConfig(names[0], language),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "name", names[0]),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "language", language),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "is_built_in", "false"),
+					resource.TestCheckResourceAttrSet("sonarcloud_quality_profile.test", "key"),
+				),
+			},
+			qualityProfileImportCheck("sonarcloud_quality_profile.test", language, names[0]),
+			{
+				Config: testAccQualityProfileConfig(names[1], language),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "name", names[1]),
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "language", language),
+				),
+			},
+			qualityProfileImportCheck("sonarcloud_quality_profile.test", language, names[1]),
+			{
+				Config: testAccQualityProfileDefaultConfig(names[1], language, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "is_default", "true"),
+				),
+			},
+			{
+				Config: testAccQualityProfileDefaultConfig(names[1], language, "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "is_default", "false"),
+				),
+			},
+			{
+				Config: testAccQualityProfileRulesConfig(names[1], language, "java:S1481", "MINOR"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarcloud_quality_profile.test", "rules.*", map[string]string{
+						"rule":     "java:S1481",
+						"severity": "MINOR",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarcloud_quality_profile.test", "rules.*", map[string]string{
+						"rule":       "java:S107",
+						"params.max": "10",
+					}),
+				),
+			},
+			{
+				Config: testAccQualityProfileRulesConfig(names[1], language, "java:S1481", "CRITICAL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarcloud_quality_profile.test", "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarcloud_quality_profile.test", "rules.*", map[string]string{
+						"rule":     "java:S1481",
+						"severity": "CRITICAL",
+					}),
+				),
+			},
+		},
+		CheckDestroy: testAccQualityProfileDestroy,
+	})
+}
+
+func testAccQualityProfileDestroy(_ *terraform.State) error {
+	return nil
+}
+
+func testAccQualityProfileConfig(name, language string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_profile" "test" {
+	name = "%s"
+	language = "%s"
+}
+`, name, language)
+}
+
+func testAccQualityProfileDefaultConfig(name, language, isDefault string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_profile" "test" {
+	name = "%s"
+	language = "%s"
+	is_default = "%s"
+}
+`, name, language, isDefault)
+}
+
+func testAccQualityProfileRulesConfig(name, language, rule, severity string) string {
+	return fmt.Sprintf(`
+resource "sonarcloud_quality_profile" "test" {
+	name = "%s"
+	language = "%s"
+	rules = [
+		{
+			rule = "%s"
+			severity = "%s"
+		},
+		{
+			rule = "java:S107"
+			params = {
+				max = "10"
+			}
+		}
+	]
+}
+`, name, language, rule, severity)
+}
+
+func qualityProfileImportCheck(resourceName, language, name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      resourceName,
+		ImportState:       true,
+		ImportStateId:     fmt.Sprintf("%s,%s", language, name),
+		ImportStateVerify: true,
+	}
+}
@@ -57,6 +57,8 @@ type resourceUserGroup struct {
 }
 
 func (r resourceUserGroup) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -103,6 +105,8 @@ func (r resourceUserGroup) Create(ctx context.Context, req tfsdk.CreateResourceR
 }
 
 func (r resourceUserGroup) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group", "read", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Group
 	diags := req.State.Get(ctx, &state)
@@ -135,6 +139,8 @@ func (r resourceUserGroup) Read(ctx context.Context, req tfsdk.ReadResourceReque
 }
 
 func (r resourceUserGroup) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group", "update", req.Plan)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Group
 	diags := req.State.Get(ctx, &state)
@@ -151,7 +157,7 @@ func (r resourceUserGroup) Update(ctx context.Context, req tfsdk.UpdateResourceR
 		return
 	}
 
-	changed := changedAttrs(req, diags)
+	changed := changedAttrs(req, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -199,6 +205,8 @@ func (r resourceUserGroup) Update(ctx context.Context, req tfsdk.UpdateResourceR
 }
 
 func (r resourceUserGroup) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group", "delete", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Group
 	diags := req.State.Get(ctx, &state)
@@ -53,6 +53,8 @@ type resourceUserGroupMember struct {
 }
 
 func (r resourceUserGroupMember) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group_member", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -95,6 +97,8 @@ func (r resourceUserGroupMember) Create(ctx context.Context, req tfsdk.CreateRes
 }
 
 func (r resourceUserGroupMember) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group_member", "read", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state GroupMember
 	diags := req.State.Get(ctx, &state)
@@ -132,6 +136,8 @@ func (r resourceUserGroupMember) Update(_ context.Context, _ tfsdk.UpdateResourc
 }
 
 func (r resourceUserGroupMember) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group_member", "delete", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state GroupMember
 	diags := req.State.Get(ctx, &state)
@@ -83,6 +83,8 @@ type resourceUserGroupPermissions struct {
 }
 
 func (r resourceUserGroupPermissions) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group_permissions", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -168,6 +170,8 @@ func (r resourceUserGroupPermissions) Create(ctx context.Context, req tfsdk.Crea
 }
 
 func (r resourceUserGroupPermissions) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group_permissions", "read", req.State)(&resp.Diagnostics)
+
 	var state UserGroupPermissions
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -208,6 +212,8 @@ func (r resourceUserGroupPermissions) Read(ctx context.Context, req tfsdk.ReadRe
 }
 
 func (r resourceUserGroupPermissions) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group_permissions", "update", req.Plan)(&resp.Diagnostics)
+
 	var state UserGroupPermissions
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -283,6 +289,8 @@ func (r resourceUserGroupPermissions) Update(ctx context.Context, req tfsdk.Upda
 }
 
 func (r resourceUserGroupPermissions) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_group_permissions", "delete", req.State)(&resp.Diagnostics)
+
 	var state UserGroupPermissions
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
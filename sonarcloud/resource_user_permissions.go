@@ -88,6 +88,8 @@ type resourceUserPermissions struct {
 }
 
 func (r resourceUserPermissions) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_permissions", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -173,6 +175,8 @@ func (r resourceUserPermissions) Create(ctx context.Context, req tfsdk.CreateRes
 }
 
 func (r resourceUserPermissions) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_permissions", "read", req.State)(&resp.Diagnostics)
+
 	var state UserPermissions
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -214,6 +218,8 @@ func (r resourceUserPermissions) Read(ctx context.Context, req tfsdk.ReadResourc
 }
 
 func (r resourceUserPermissions) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_permissions", "update", req.Plan)(&resp.Diagnostics)
+
 	var state UserPermissions
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -288,6 +294,8 @@ func (r resourceUserPermissions) Update(ctx context.Context, req tfsdk.UpdateRes
 }
 
 func (r resourceUserPermissions) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_permissions", "delete", req.State)(&resp.Diagnostics)
+
 	var state UserPermissions
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
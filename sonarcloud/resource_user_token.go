@@ -57,6 +57,8 @@ type resourceUserToken struct {
 }
 
 func (r resourceUserToken) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_token", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -101,6 +103,8 @@ func (r resourceUserToken) Create(ctx context.Context, req tfsdk.CreateResourceR
 }
 
 func (r resourceUserToken) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_token", "read", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Token
 	diags := req.State.Get(ctx, &state)
@@ -138,6 +142,8 @@ func (r resourceUserToken) Update(_ context.Context, _ tfsdk.UpdateResourceReque
 }
 
 func (r resourceUserToken) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_user_token", "delete", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Token
 	diags := req.State.Get(ctx, &state)
@@ -67,6 +67,8 @@ type resourceWebhook struct {
 }
 
 func (r resourceWebhook) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_webhook", "create", req.Plan)(&resp.Diagnostics)
+
 	if !r.p.configured {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -118,6 +120,8 @@ func (r resourceWebhook) Create(ctx context.Context, req tfsdk.CreateResourceReq
 }
 
 func (r resourceWebhook) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_webhook", "read", req.State)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Webhook
 	diags := req.State.Get(ctx, &state)
@@ -151,6 +155,8 @@ func (r resourceWebhook) Read(ctx context.Context, req tfsdk.ReadResourceRequest
 }
 
 func (r resourceWebhook) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_webhook", "update", req.Plan)(&resp.Diagnostics)
+
 	// Retrieve values from state
 	var state Webhook
 	diags := req.State.Get(ctx, &state)
@@ -209,6 +215,8 @@ func (r resourceWebhook) Update(ctx context.Context, req tfsdk.UpdateResourceReq
 }
 
 func (r resourceWebhook) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	defer logResourceOperation(ctx, r.p, "sonarcloud_webhook", "delete", req.State)(&resp.Diagnostics)
+
 	var state Webhook
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -2,7 +2,16 @@ package sonarcloud
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -151,3 +160,306 @@ func (v allowedSetOptionsValidator) Validate(ctx context.Context, req tfsdk.Vali
 		}
 	}
 }
+
+type globPatternsValidator struct{}
+
+func globPatterns() *globPatternsValidator {
+	return &globPatternsValidator{}
+}
+
+func (v globPatternsValidator) Description(_ context.Context) string {
+	return "values in set must be valid glob patterns, e.g. **/vendor/**"
+}
+
+func (v globPatternsValidator) MarkdownDescription(_ context.Context) string {
+	return "values in set must be valid glob patterns, e.g. `**/vendor/**`"
+}
+
+func (v globPatternsValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var set types.Set
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &set)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if set.Unknown || set.Null {
+		return
+	}
+
+	for _, elem := range set.Elems {
+		str, ok := elem.(types.String)
+		if !ok || str.Unknown || str.Null {
+			continue
+		}
+
+		if err := validateGlobPattern(str.Value); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Invalid Glob Pattern in Set",
+				fmt.Sprintf("Element must be a valid glob pattern, got: %q: %s.", str.Value, err),
+			)
+
+			return
+		}
+	}
+}
+
+// validateGlobPattern checks if the pattern is a valid SonarCloud file pattern
+func validateGlobPattern(pattern string) error {
+	if strings.TrimSpace(pattern) == "" {
+		return fmt.Errorf("pattern must not be empty")
+	}
+	if strings.TrimSpace(pattern) != pattern {
+		return fmt.Errorf("pattern must not start or end with whitespace")
+	}
+	// Multi-value settings are stored comma-separated, so a comma would split the pattern in two
+	if strings.Contains(pattern, ",") {
+		return fmt.Errorf("pattern must not contain a comma")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return err
+	}
+	return nil
+}
+
+type projectTagsValidator struct{}
+
+func projectTags() *projectTagsValidator {
+	return &projectTagsValidator{}
+}
+
+func (v projectTagsValidator) Description(_ context.Context) string {
+	return "values in set must be lowercase tags without whitespace or commas, e.g. team-a"
+}
+
+func (v projectTagsValidator) MarkdownDescription(_ context.Context) string {
+	return "values in set must be lowercase tags without whitespace or commas, e.g. `team-a`"
+}
+
+func (v projectTagsValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var set types.Set
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &set)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if set.Unknown || set.Null {
+		return
+	}
+
+	for _, elem := range set.Elems {
+		str, ok := elem.(types.String)
+		if !ok || str.Unknown || str.Null {
+			continue
+		}
+
+		if err := validateProjectTag(str.Value); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Invalid Tag in Set",
+				fmt.Sprintf("Element must be a valid tag, got: %q: %s.", str.Value, err),
+			)
+
+			return
+		}
+	}
+}
+
+// validateProjectTag checks if the tag is stored by SonarCloud as is. SonarCloud lowercases tags, which would cause a diff on every plan.
+func validateProjectTag(tag string) error {
+	if tag == "" {
+		return fmt.Errorf("tag must not be empty")
+	}
+	if strings.ToLower(tag) != tag {
+		return fmt.Errorf("tag must be lowercase")
+	}
+	// Tags are sent comma-separated, so a comma would split the tag in two
+	if strings.ContainsAny(tag, ", \t\n") {
+		return fmt.Errorf("tag must not contain whitespace or commas")
+	}
+	return nil
+}
+
+type regexPatternValidator struct{}
+
+func regexPattern() *regexPatternValidator {
+	return &regexPatternValidator{}
+}
+
+func (v regexPatternValidator) Description(_ context.Context) string {
+	return "string must be a valid regular expression, e.g. (branch|release)-.*"
+}
+
+func (v regexPatternValidator) MarkdownDescription(_ context.Context) string {
+	return "string must be a valid regular expression, e.g. `(branch|release)-.*`"
+}
+
+func (v regexPatternValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var str types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &str)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if str.Unknown || str.Null {
+		return
+	}
+
+	warning, err := validateRegexPattern(str.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Regular Expression",
+			fmt.Sprintf("String must be a valid regular expression, got: %q: %s.", str.Value, err),
+		)
+
+		return
+	}
+
+	if warning != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			req.AttributePath,
+			"Unverified Regular Expression",
+			fmt.Sprintf("The regular expression %q could not be verified, so SonarCloud might reject it: %s.", str.Value, warning),
+		)
+	}
+}
+
+// invalidRegexErrorCodes are the syntax errors of patterns that are invalid in Java as well
+var invalidRegexErrorCodes = map[syntax.ErrorCode]struct{}{
+	syntax.ErrMissingParen:          {},
+	syntax.ErrUnexpectedParen:       {},
+	syntax.ErrMissingBracket:        {},
+	syntax.ErrTrailingBackslash:     {},
+	syntax.ErrMissingRepeatArgument: {},
+	syntax.ErrInvalidRepeatOp:       {},
+	syntax.ErrInvalidCharRange:      {},
+}
+
+// validateRegexPattern checks if the pattern is a non-empty, compilable regular expression.
+// SonarCloud uses Java regular expressions, which support constructs that Go does not, e.g. lookarounds and backreferences.
+// A pattern that only fails to compile because of such a construct is returned as a warning instead of an error.
+func validateRegexPattern(pattern string) (warning error, err error) {
+	if strings.TrimSpace(pattern) == "" {
+		return nil, fmt.Errorf("pattern must not be empty")
+	}
+
+	_, err = regexp.Compile(pattern)
+	var syntaxErr *syntax.Error
+	if !errors.As(err, &syntaxErr) {
+		return err, nil
+	}
+	if _, ok := invalidRegexErrorCodes[syntaxErr.Code]; ok {
+		return nil, err
+	}
+	return err, nil
+}
+
+// catalogMetric is a metric that quality gate conditions can be based on
+type catalogMetric struct {
+	Key  string
+	Type string
+	// Direction is 1 if higher values are better, -1 if lower values are better, and 0 otherwise
+	Direction int
+}
+
+// validateConditions checks the metric, error threshold and operator of each condition against the metrics in the catalog.
+// The catalog is retrieved from SonarCloud, so this is done when planning instead of when validating the configuration.
+func validateConditions(conditionsPath tfpath.Path, conditions types.Set, catalog map[string]catalogMetric, diags *diag.Diagnostics) {
+	if conditions.Unknown || conditions.Null {
+		return
+	}
+
+	for _, elem := range conditions.Elems {
+		condition, ok := elem.(types.Object)
+		if !ok || condition.Unknown || condition.Null {
+			continue
+		}
+
+		validateCondition(conditionsPath.AtSetValue(elem), condition, catalog, diags)
+	}
+}
+
+// validateCondition checks the metric, error threshold and operator of the condition at the given path
+func validateCondition(conditionPath tfpath.Path, condition types.Object, catalog map[string]catalogMetric, diags *diag.Diagnostics) {
+	metricKey, ok := knownStringAttr(condition, "metric")
+	if !ok {
+		return
+	}
+	metric, ok := catalog[metricKey]
+	if !ok {
+		diags.AddAttributeError(
+			conditionPath.AtName("metric"),
+			"Unknown Metric",
+			fmt.Sprintf("Metric must be one of the keys listed by the sonarcloud_metrics data source, got: %s.", metricKey),
+		)
+		return
+	}
+
+	if threshold, ok := knownStringAttr(condition, "error"); ok {
+		if err := validateConditionThreshold(metric, threshold); err != nil {
+			diags.AddAttributeError(
+				conditionPath.AtName("error"),
+				"Invalid Condition Threshold",
+				fmt.Sprintf("Threshold of metric %s must be %s, got: %q.", metric.Key, err, threshold),
+			)
+		}
+	}
+
+	if op, ok := knownStringAttr(condition, "op"); ok {
+		if err := validateConditionOp(metric, op); err != nil {
+			diags.AddAttributeError(
+				conditionPath.AtName("op"),
+				"Invalid Condition Operator",
+				fmt.Sprintf("Operator of metric %s %s, got: %s.", metric.Key, err, op),
+			)
+		}
+	}
+}
+
+// knownStringAttr returns the value of the string attribute of the object, if it is known and not null
+func knownStringAttr(object types.Object, name string) (string, bool) {
+	str, ok := object.Attrs[name].(types.String)
+	if !ok || str.Unknown || str.Null {
+		return "", false
+	}
+	return str.Value, true
+}
+
+// validateConditionThreshold checks if the threshold can be parsed as a value of the type of the metric
+func validateConditionThreshold(metric catalogMetric, threshold string) error {
+	switch metric.Type {
+	case "INT", "MILLISEC", "WORK_DUR":
+		if _, err := strconv.ParseInt(threshold, 10, 64); err != nil {
+			return fmt.Errorf("a whole number")
+		}
+	case "FLOAT":
+		if _, err := strconv.ParseFloat(threshold, 64); err != nil {
+			return fmt.Errorf("a number")
+		}
+	case "PERCENT":
+		// Written as a negation, so that NaN is rejected as well
+		if value, err := strconv.ParseFloat(threshold, 64); err != nil || !(value >= 0 && value <= 100) {
+			return fmt.Errorf("a percentage between 0 and 100")
+		}
+	case "RATING":
+		if value, err := strconv.Atoi(threshold); err != nil || value < 1 || value > 5 {
+			return fmt.Errorf("a rating between 1 (A) and 5 (E)")
+		}
+	}
+	return nil
+}
+
+// validateConditionOp checks if the operator makes the condition fail on worse values of the metric
+func validateConditionOp(metric catalogMetric, op string) error {
+	switch {
+	case metric.Direction > 0 && op != "LT":
+		return fmt.Errorf("must be LT, because higher values are better")
+	case metric.Direction < 0 && op != "GT":
+		return fmt.Errorf("must be GT, because lower values are better")
+	}
+	return nil
+}
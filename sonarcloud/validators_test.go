@@ -0,0 +1,388 @@
+package sonarcloud
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validate runs the validator against the value and returns whether it reported an error
+func validate(t *testing.T, validator tfsdk.AttributeValidator, value attr.Value) bool {
+	t.Helper()
+	req := tfsdk.ValidateAttributeRequest{
+		AttributePath:   tfpath.Root("test"),
+		AttributeConfig: value,
+	}
+	resp := &tfsdk.ValidateAttributeResponse{}
+	validator.Validate(context.Background(), req, resp)
+	return resp.Diagnostics.HasError()
+}
+
+func TestStringValidators(t *testing.T) {
+	tests := map[string]struct {
+		validator tfsdk.AttributeValidator
+		value     types.String
+		invalid   bool
+	}{
+		"length within bounds": {validator: stringLengthBetween(1, 3), value: types.String{Value: "abc"}},
+		"length too short":     {validator: stringLengthBetween(1, 3), value: types.String{Value: ""}, invalid: true},
+		"length too long":      {validator: stringLengthBetween(1, 3), value: types.String{Value: "abcd"}, invalid: true},
+		"length of null":       {validator: stringLengthBetween(1, 3), value: types.String{Null: true}},
+		"allowed option":       {validator: allowedOptions("LT", "GT"), value: types.String{Value: "GT"}},
+		"disallowed option":    {validator: allowedOptions("LT", "GT"), value: types.String{Value: "EQ"}, invalid: true},
+		"option is case exact": {validator: allowedOptions("LT", "GT"), value: types.String{Value: "lt"}, invalid: true},
+		"unknown option":       {validator: allowedOptions("LT", "GT"), value: types.String{Unknown: true}},
+		"valid regex":          {validator: regexPattern(), value: types.String{Value: "(branch|release)-.*"}},
+		"invalid regex":        {validator: regexPattern(), value: types.String{Value: "(branch"}, invalid: true},
+		"java regex":           {validator: regexPattern(), value: types.String{Value: "(?!feature/).*"}},
+		"empty regex":          {validator: regexPattern(), value: types.String{Value: " "}, invalid: true},
+		"null regex":           {validator: regexPattern(), value: types.String{Null: true}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if invalid := validate(t, test.validator, test.value); invalid != test.invalid {
+				t.Errorf("expected invalid to be %t for %v, got %t", test.invalid, test.value, invalid)
+			}
+		})
+	}
+}
+
+func TestSetValidators(t *testing.T) {
+	tests := map[string]struct {
+		validator tfsdk.AttributeValidator
+		value     types.Set
+		invalid   bool
+	}{
+		"allowed options":       {validator: allowedSetOptions("admin", "scan"), value: testStringSet("scan", "admin")},
+		"disallowed option":     {validator: allowedSetOptions("admin", "scan"), value: testStringSet("scan", "user"), invalid: true},
+		"empty options":         {validator: allowedSetOptions("admin", "scan"), value: testStringSet()},
+		"null options":          {validator: allowedSetOptions("admin", "scan"), value: types.Set{ElemType: types.StringType, Null: true}},
+		"valid globs":           {validator: globPatterns(), value: testStringSet("**/vendor/**", "*.go")},
+		"invalid glob":          {validator: globPatterns(), value: testStringSet("**/vendor/**", "[a-"), invalid: true},
+		"glob with a comma":     {validator: globPatterns(), value: testStringSet("**/{a,b}/**"), invalid: true},
+		"unknown glob":          {validator: globPatterns(), value: types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Unknown: true}}}},
+		"unknown set of globs":  {validator: globPatterns(), value: types.Set{ElemType: types.StringType, Unknown: true}},
+		"empty set of globs":    {validator: globPatterns(), value: testStringSet()},
+		"glob with whitespace":  {validator: globPatterns(), value: testStringSet(" *.go"), invalid: true},
+		"empty glob in the set": {validator: globPatterns(), value: testStringSet(""), invalid: true},
+		"lowercase tags":        {validator: projectTags(), value: testStringSet("team-a", "c++", "java")},
+		"uppercase tag":         {validator: projectTags(), value: testStringSet("team-a", "Team-B"), invalid: true},
+		"tag with a comma":      {validator: projectTags(), value: testStringSet("team-a,team-b"), invalid: true},
+		"tag with whitespace":   {validator: projectTags(), value: testStringSet("team a"), invalid: true},
+		"empty tag":             {validator: projectTags(), value: testStringSet(""), invalid: true},
+		"unknown set of tags":   {validator: projectTags(), value: types.Set{ElemType: types.StringType, Unknown: true}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if invalid := validate(t, test.validator, test.value); invalid != test.invalid {
+				t.Errorf("expected invalid to be %t for %v, got %t", test.invalid, test.value, invalid)
+			}
+		})
+	}
+}
+
+func TestValidateGlobPattern(t *testing.T) {
+	tests := map[string]bool{
+		"**/vendor/**":    true,
+		"src/**/*.go":     true,
+		"*_test.[ch]":     true,
+		"":                false,
+		"   ":             false,
+		"*.go ":           false,
+		"**/{a,b}/**":     false,
+		"[a-":             false,
+		"src/\\":          false,
+		"**/generated/**": true,
+	}
+
+	for pattern, valid := range tests {
+		t.Run(pattern, func(t *testing.T) {
+			if err := validateGlobPattern(pattern); (err == nil) != valid {
+				t.Errorf("expected %q to be valid: %t, got error: %v", pattern, valid, err)
+			}
+		})
+	}
+}
+
+func FuzzValidateGlobPattern(f *testing.F) {
+	for _, seed := range []string{"**/vendor/**", "*.go", "[a-", " a", "a,b", ""} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, pattern string) {
+		if err := validateGlobPattern(pattern); err != nil {
+			return
+		}
+		if strings.TrimSpace(pattern) != pattern || pattern == "" || strings.Contains(pattern, ",") {
+			t.Errorf("accepted %q, which is empty, padded with whitespace or contains a comma", pattern)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			t.Errorf("accepted %q, which is not a valid pattern: %v", pattern, err)
+		}
+	})
+}
+
+func TestRegexPatternWarning(t *testing.T) {
+	// Patterns that are invalid in Java as well are errors, Java-only constructs are warnings
+	tests := map[string]diag.Severity{
+		"(branch|release)-.*": diag.SeverityInvalid,
+		"(?!feature/).*":      diag.SeverityWarning,
+		"(?<!release/).*":     diag.SeverityWarning,
+		`(\w+)-\1`:            diag.SeverityWarning,
+		"release/(":           diag.SeverityError,
+		"[abc":                diag.SeverityError,
+		"a**":                 diag.SeverityError,
+		`release\`:            diag.SeverityError,
+	}
+
+	for pattern, severity := range tests {
+		t.Run(pattern, func(t *testing.T) {
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   tfpath.Root("test"),
+				AttributeConfig: types.String{Value: pattern},
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+			regexPattern().Validate(context.Background(), req, resp)
+
+			got := diag.SeverityInvalid
+			if len(resp.Diagnostics) > 0 {
+				got = resp.Diagnostics[0].Severity()
+			}
+			if len(resp.Diagnostics) > 1 || got != severity {
+				t.Errorf("expected a diagnostic with severity %s for %q, got: %v", severity, pattern, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateRegexPattern(t *testing.T) {
+	tests := map[string]bool{
+		"(branch|release)-.*": true,
+		"release/.*":          true,
+		"^main$":              true,
+		"":                    false,
+		`release-\d+`:         true,
+		"\t":                  false,
+		" ":                   false,
+		"(?!feature/).*":      true,
+		`(\w+)-\1`:            true,
+		"release/(":           false,
+		"release)":            false,
+		"[abc":                false,
+		`release\`:            false,
+		"*release":            false,
+		"a**":                 false,
+		"[z-a]":               false,
+	}
+
+	for pattern, valid := range tests {
+		t.Run(pattern, func(t *testing.T) {
+			if _, err := validateRegexPattern(pattern); (err == nil) != valid {
+				t.Errorf("expected %q to be valid: %t, got error: %v", pattern, valid, err)
+			}
+		})
+	}
+}
+
+func FuzzValidateRegexPattern(f *testing.F) {
+	for _, seed := range []string{"(branch|release)-.*", "(?!feature/).*", "(release", " ", ""} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, pattern string) {
+		warning, err := validateRegexPattern(pattern)
+		if err != nil {
+			return
+		}
+		if strings.TrimSpace(pattern) == "" {
+			t.Errorf("accepted the empty pattern %q", pattern)
+		}
+		if _, compileErr := regexp.Compile(pattern); (compileErr != nil) != (warning != nil) {
+			t.Errorf("expected a warning for %q: %t, got: %v", pattern, compileErr != nil, warning)
+		}
+	})
+}
+
+// testMetricCatalog holds metrics of each type and direction, like the catalog that is retrieved from SonarCloud
+var testMetricCatalog = map[string]catalogMetric{
+	"new_bugs":                     {Key: "new_bugs", Type: "INT", Direction: -1},
+	"new_technical_debt":           {Key: "new_technical_debt", Type: "WORK_DUR", Direction: -1},
+	"new_coverage":                 {Key: "new_coverage", Type: "PERCENT", Direction: 1},
+	"new_duplicated_lines_density": {Key: "new_duplicated_lines_density", Type: "PERCENT", Direction: -1},
+	"new_security_rating":          {Key: "new_security_rating", Type: "RATING", Direction: -1},
+	"test_execution_time":          {Key: "test_execution_time", Type: "MILLISEC", Direction: -1},
+	"custom_score":                 {Key: "custom_score", Type: "FLOAT", Direction: 1},
+	"quality_gate_details":         {Key: "quality_gate_details", Type: "DATA", Direction: 0},
+}
+
+func TestValidateConditionThreshold(t *testing.T) {
+	tests := map[string]struct {
+		metric    string
+		threshold string
+		valid     bool
+	}{
+		"whole number":             {metric: "new_bugs", threshold: "0", valid: true},
+		"negative whole number":    {metric: "new_bugs", threshold: "-1", valid: true},
+		"fraction for a number":    {metric: "new_bugs", threshold: "1.5"},
+		"text for a number":        {metric: "new_bugs", threshold: "none"},
+		"duration in minutes":      {metric: "new_technical_debt", threshold: "60", valid: true},
+		"duration with a unit":     {metric: "new_technical_debt", threshold: "1h"},
+		"percentage":               {metric: "new_coverage", threshold: "80", valid: true},
+		"fractional percentage":    {metric: "new_coverage", threshold: "80.5", valid: true},
+		"lowest percentage":        {metric: "new_duplicated_lines_density", threshold: "0", valid: true},
+		"highest percentage":       {metric: "new_coverage", threshold: "100", valid: true},
+		"percentage above 100":     {metric: "new_coverage", threshold: "100.1"},
+		"negative percentage":      {metric: "new_coverage", threshold: "-1"},
+		"NaN percentage":           {metric: "new_coverage", threshold: "NaN"},
+		"infinite percentage":      {metric: "new_coverage", threshold: "Inf"},
+		"best rating":              {metric: "new_security_rating", threshold: "1", valid: true},
+		"worst rating":             {metric: "new_security_rating", threshold: "5", valid: true},
+		"rating out of range":      {metric: "new_security_rating", threshold: "6"},
+		"letter rating":            {metric: "new_security_rating", threshold: "A"},
+		"threshold of data metric": {metric: "quality_gate_details", threshold: "anything", valid: true},
+		"fractional number":        {metric: "custom_score", threshold: "0.5", valid: true},
+		"text for a fraction":      {metric: "custom_score", threshold: "half"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			metric, ok := testMetricCatalog[test.metric]
+			if !ok {
+				t.Fatalf("metric %s is not in the catalog", test.metric)
+			}
+			if err := validateConditionThreshold(metric, test.threshold); (err == nil) != test.valid {
+				t.Errorf("expected %q to be valid: %t, got error: %v", test.threshold, test.valid, err)
+			}
+		})
+	}
+}
+
+func FuzzValidateConditionThreshold(f *testing.F) {
+	for _, seed := range []string{"0", "1", "5", "80.5", "100", "-1", "NaN", "Inf", "1e2", "0x10", ""} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, threshold string) {
+		for _, metric := range testMetricCatalog {
+			if err := validateConditionThreshold(metric, threshold); err != nil {
+				continue
+			}
+
+			switch metric.Type {
+			case "INT", "MILLISEC", "WORK_DUR":
+				if _, err := strconv.ParseInt(threshold, 10, 64); err != nil {
+					t.Errorf("accepted %q for %s, which is not a whole number", threshold, metric.Key)
+				}
+			case "PERCENT":
+				if value, err := strconv.ParseFloat(threshold, 64); err != nil || !(value >= 0 && value <= 100) {
+					t.Errorf("accepted %q for %s, which is not a percentage", threshold, metric.Key)
+				}
+			case "RATING":
+				if value, err := strconv.Atoi(threshold); err != nil || value < 1 || value > 5 {
+					t.Errorf("accepted %q for %s, which is not a rating", threshold, metric.Key)
+				}
+			}
+		}
+	})
+}
+
+func TestValidateConditionOp(t *testing.T) {
+	tests := map[string]struct {
+		metric string
+		op     string
+		valid  bool
+	}{
+		"higher is better with LT": {metric: "new_coverage", op: "LT", valid: true},
+		"higher is better with GT": {metric: "new_coverage", op: "GT"},
+		"lower is better with GT":  {metric: "new_bugs", op: "GT", valid: true},
+		"lower is better with LT":  {metric: "new_bugs", op: "LT"},
+		"no direction with LT":     {metric: "quality_gate_details", op: "LT", valid: true},
+		"no direction with GT":     {metric: "quality_gate_details", op: "GT", valid: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			metric := testMetricCatalog[test.metric]
+			if err := validateConditionOp(metric, test.op); (err == nil) != test.valid {
+				t.Errorf("expected %s on %s to be valid: %t, got error: %v", test.op, test.metric, test.valid, err)
+			}
+		})
+	}
+}
+
+func testCondition(metric, threshold, op attr.Value) types.Object {
+	return types.Object{
+		AttrTypes: conditionType.AttrTypes,
+		Attrs: map[string]attr.Value{
+			"id":     types.Float64{Null: true},
+			"metric": metric,
+			"error":  threshold,
+			"op":     op,
+		},
+	}
+}
+
+func TestValidateConditions(t *testing.T) {
+	tests := map[string]struct {
+		conditions []attr.Value
+		invalid    bool
+	}{
+		"valid conditions": {
+			conditions: []attr.Value{
+				testCondition(types.String{Value: "new_coverage"}, types.String{Value: "80"}, types.String{Value: "LT"}),
+				testCondition(types.String{Value: "new_security_rating"}, types.String{Value: "1"}, types.String{Value: "GT"}),
+			},
+		},
+		"invalid threshold": {
+			conditions: []attr.Value{
+				testCondition(types.String{Value: "new_security_rating"}, types.String{Value: "A"}, types.String{Value: "GT"}),
+			},
+			invalid: true,
+		},
+		"invalid operator": {
+			conditions: []attr.Value{
+				testCondition(types.String{Value: "new_coverage"}, types.String{Value: "80"}, types.String{Value: "GT"}),
+			},
+			invalid: true,
+		},
+		"unknown metric": {
+			conditions: []attr.Value{
+				testCondition(types.String{Value: "new_covfefe"}, types.String{Value: "A"}, types.String{Value: "GT"}),
+			},
+			invalid: true,
+		},
+		"custom metric": {
+			conditions: []attr.Value{
+				testCondition(types.String{Value: "custom_score"}, types.String{Value: "0.5"}, types.String{Value: "LT"}),
+			},
+		},
+		"unknown values": {
+			conditions: []attr.Value{
+				testCondition(types.String{Unknown: true}, types.String{Value: "A"}, types.String{Value: "GT"}),
+				testCondition(types.String{Value: "new_coverage"}, types.String{Unknown: true}, types.String{Null: true}),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			set := types.Set{ElemType: conditionType, Elems: test.conditions}
+			validateConditions(tfpath.Root("conditions"), set, testMetricCatalog, &diags)
+			if invalid := diags.HasError(); invalid != test.invalid {
+				t.Errorf("expected invalid to be %t, got %t", test.invalid, invalid)
+			}
+		})
+	}
+}